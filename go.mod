@@ -2,4 +2,8 @@ module github.com/eu-evops/terraform-provider-harness
 
 go 1.15
 
-require github.com/hashicorp/terraform-plugin-sdk/v2 v2.1.0
+require (
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.1.0
+	gopkg.in/yaml.v2 v2.2.4
+)
@@ -1,246 +1,113 @@
 package harness
 
 import (
-	"fmt"
-	"strings"
+	"context"
 )
 
-type CreateApplicationWrapper struct {
-	Application *Application `json:"application"`
-}
-
-type CreateApplicationOperationWrapper struct {
-	CreateApplication *CreateApplicationWrapper `json:"createApplication"`
-}
-
-type CreateApplicationApiResponse struct {
-	Errors []Error                           `json:"errors"`
-	Data   CreateApplicationOperationWrapper `json:"data"`
-}
-
-type UpdateApplicationOperationWrapper struct {
-	UpdateApplication *ApplicationWrapper `json:"updateApplication"`
-}
-
-type UpdateApplicationApiResponse struct {
-	Errors []Error                           `json:"errors"`
-	Data   UpdateApplicationOperationWrapper `json:"data"`
-}
-
-type ApplicationWrapper struct {
-	Application *Application `json:"application"`
-}
-
-type GetApplicationApiResponse struct {
-	Errors []Error             `json:"errors"`
-	Data   *ApplicationWrapper `json:"data"`
-}
-
-type DeleteApplicationApiResponse struct {
-	Errors []Error             `json:"errors"`
-	Data   *ApplicationWrapper `json:"data"`
-}
-
-type Application struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-}
-
-type ApplicationRetrievalError struct{}
-
-func (e *ApplicationRetrievalError) Error() string {
-	return fmt.Sprintf("ApplicationRetrievalError")
-}
-
-type UserNotAuthorisedError struct{}
-
-func (e *UserNotAuthorisedError) Error() string {
-	return fmt.Sprintf("UserNotAuthorisedError")
-}
-
-type ApplicationNotFound struct{}
+func (h *Client) GetApplication(ctx context.Context, id string) (*Application, error) {
+	h.logger.Debugf("Getting a Harness.io application with id '%s'", id)
 
-func (e *ApplicationNotFound) Error() string {
-	return fmt.Sprintf("ApplicationNotFound")
-}
-
-func (h *Client) GetApplication(id string) (*Application, error) {
-	fmt.Print("Getting a Harness.io application with id '%s'", id)
-
-	query := `query {
-		application(applicationId: "%s"){
-			id
-			name
-			description
-		}
-	}
-	`
-
-	graphQlQuery := &GraphQLQuery{
-		Query: fmt.Sprintf(query, id),
-	}
-	apiResponse := &GetApplicationApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
+	app, err := h.application(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(apiResponse.Errors) > 0 {
-
-		if strings.Contains(apiResponse.Errors[0].Message, "User not authorized") {
-			return nil, &UserNotAuthorisedError{}
-		}
-
-		return nil, fmt.Errorf("Errors: %#v", apiResponse.Errors)
+	if app == nil {
+		return nil, newNotFoundError("application")
 	}
 
-	return apiResponse.Data.Application, nil
+	return app, nil
 }
 
-func (h *Client) GetApplicationByName(name string) (*Application, error) {
-	fmt.Print("Getting a Harness.io application with name '%s'", name)
-
-	query := `query {
-		applicationByName(name: "%s"){
-			id
-			name
-			description
-		}
-	}
-	`
+func (h *Client) GetApplicationByName(ctx context.Context, name string) (*Application, error) {
+	h.logger.Debugf("Getting a Harness.io application with name '%s'", name)
 
-	graphQlQuery := &GraphQLQuery{
-		Query: fmt.Sprintf(query, name),
-	}
-	apiResponse := &GetApplicationApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
+	app, err := h.applicationByName(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(apiResponse.Errors) > 0 {
-		if strings.Contains(apiResponse.Errors[0].Message, "Application does not exist") {
-			return nil, &ApplicationNotFound{}
-		}
-
-		return nil, fmt.Errorf("Errors: %#v", apiResponse.Errors)
+	if app == nil {
+		return nil, newNotFoundError("application")
 	}
 
-	return apiResponse.Data.Application, nil
+	return app, nil
 }
 
-func (h *Client) DeleteApplication(id string) error {
-	fmt.Print("Deleting a Harness.io application with id '%s'", id)
+func (h *Client) DeleteApplication(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io application with id '%s'", id)
 
-	query := `mutation($app: DeleteApplicationInput!){
-		deleteApplication(input: $app) {
-			clientMutationId
-		}
-	}
-	`
-
-	graphQlQuery := &GraphQLQuery{
-		Query: query,
-		Variables: map[string]interface{}{
-			"app": map[string]string{
-				"applicationId": id,
-			},
-		},
-	}
+	_, err := h.deleteApplication(ctx, &DeleteApplicationInput{
+		ApplicationID: id,
+	})
+
+	return err
+}
 
-	apiResponse := &DeleteApplicationApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
+func (h *Client) NewApplication(ctx context.Context, a *Application) (*Application, error) {
+	h.logger.Debugf("Creating a Harness.io application with name '%s'", a.Name)
 
+	payload, err := h.createApplication(ctx, &CreateApplicationInput{
+		Name:        a.Name,
+		Description: String(a.Description),
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if len(apiResponse.Errors) > 0 {
-		return fmt.Errorf("Errors: %#v", apiResponse.Errors)
+	if payload == nil || payload.Application == nil {
+		return nil, newNotFoundError("application")
 	}
 
-	return nil
+	return payload.Application, nil
 }
 
-func (h *Client) NewApplication(a *Application) (*Application, error) {
-	fmt.Print("Creating a Harness.io application with name '%s'", a.Name)
-
-	query := `mutation createApp($app: CreateApplicationInput!){
-		createApplication(input: $app){
-			application {
-				id
-				name
-				description
-			}
-		}
-	}
-	`
-
-	graphQlQuery := &GraphQLQuery{
-		OperationName: "createApp",
-		Query:         query,
-		Variables: map[string]interface{}{
-			"app": map[string]string{
-				"name":        a.Name,
-				"description": a.Description,
-			},
-		},
-	}
-
-	apiResponse := &CreateApplicationApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
+func (h *Client) UpdateApplication(ctx context.Context, a *Application) (*Application, error) {
+	h.logger.Debugf("Updating a Harness.io application with id '%s'", a.ID)
 
+	payload, err := h.updateApplication(ctx, &UpdateApplicationInput{
+		ApplicationID: a.ID,
+		Name:          String(a.Name),
+		Description:   String(a.Description),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(apiResponse.Errors) > 0 {
-		return nil, fmt.Errorf("Errors: %#v", apiResponse.Errors)
+	if payload == nil || payload.Application == nil {
+		return nil, newNotFoundError("application")
 	}
 
-	return apiResponse.Data.CreateApplication.Application, nil
+	return payload.Application, nil
 }
 
-func (h *Client) UpdateApplication(a *Application) (*Application, error) {
-	fmt.Print("Updating a Harness.io application with id '%s'", a.ID)
+// ApplicationIterator walks the applications of the account, see
+// ListApplications.
+type ApplicationIterator struct {
+	pager
+	page []*Application
+}
 
-	query := `mutation updateApp($app: UpdateApplicationInput!){
-		updateApplication(input: $app){
-			application {
-				id
-				name
-				description
-			}
-		}
-	}
-	`
-
-	graphQlQuery := &GraphQLQuery{
-		OperationName: "updateApp",
-		Query:         query,
-		Variables: map[string]interface{}{
-			"app": map[string]string{
-				"applicationId": a.ID,
-				"name":          a.Name,
-				"description":   a.Description,
-			},
-		},
-	}
+// Application returns the application the iterator is positioned at.
+func (it *ApplicationIterator) Application() *Application {
+	return it.page[it.index]
+}
 
-	apiResponse := &UpdateApplicationApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
-	if err != nil {
-		return nil, err
-	}
+// ListApplications returns an iterator over every application of the account,
+// fetching them a page at a time as the iterator advances.
+func (h *Client) ListApplications(opts *ListOptions) *ApplicationIterator {
+	it := &ApplicationIterator{}
+	it.pager = newPager(opts.pageSize(), func(ctx context.Context, limit int, offset int) (int, *PageInfo, error) {
+		h.logger.Debugf("Listing Harness.io applications from offset %d", offset)
 
-	if len(apiResponse.Errors) > 0 {
-		return nil, fmt.Errorf("Errors: %#v", apiResponse.Errors)
-	}
+		conn, err := h.applications(ctx, limit, Int(offset), nil)
+		if err != nil || conn == nil {
+			return 0, nil, err
+		}
 
-	app := &Application{}
-	app.ID = apiResponse.Data.UpdateApplication.Application.ID
-	app.Name = apiResponse.Data.UpdateApplication.Application.Name
+		it.page = conn.Nodes
+		return len(conn.Nodes), conn.PageInfo, nil
+	})
 
-	return app, nil
+	return it
 }
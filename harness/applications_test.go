@@ -0,0 +1,156 @@
+package harness_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+)
+
+func TestApplicationCRUD(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	app, err := client.NewApplication(ctx, &harness.Application{Name: "app", Description: "first"})
+	if err != nil {
+		t.Fatalf("NewApplication: %v", err)
+	}
+	if app.ID == "" || app.Name != "app" || app.Description != "first" {
+		t.Errorf("NewApplication returned %+v", app)
+	}
+
+	stored, ok := server.Entity("application", app.ID)
+	if !ok || stored["name"] != "app" {
+		t.Errorf("stored application = %v", stored)
+	}
+
+	if got, err := client.GetApplication(ctx, app.ID); err != nil || got.Name != "app" {
+		t.Errorf("GetApplication = %+v, %v", got, err)
+	}
+	if got, err := client.GetApplicationByName(ctx, "app"); err != nil || got.ID != app.ID {
+		t.Errorf("GetApplicationByName = %+v, %v", got, err)
+	}
+
+	app.Name, app.Description = "renamed", "second"
+	if _, err := client.UpdateApplication(ctx, app); err != nil {
+		t.Fatalf("UpdateApplication: %v", err)
+	}
+	if stored, _ := server.Entity("application", app.ID); stored["name"] != "renamed" || stored["description"] != "second" {
+		t.Errorf("stored application after update = %v", stored)
+	}
+
+	if err := client.DeleteApplication(ctx, app.ID); err != nil {
+		t.Fatalf("DeleteApplication: %v", err)
+	}
+	if _, ok := server.Entity("application", app.ID); ok {
+		t.Error("application still stored after DeleteApplication")
+	}
+
+	// Harness.io reports unknown application ids as unauthorized
+	if _, err := client.GetApplication(ctx, app.ID); !errors.Is(err, harness.ErrUnauthorized) {
+		t.Errorf("GetApplication of a deleted application returned %v, want ErrUnauthorized", err)
+	}
+	if _, err := client.GetApplicationByName(ctx, "renamed"); !errors.Is(err, harness.ErrNotFound) {
+		t.Errorf("GetApplicationByName of a deleted application returned %v, want ErrNotFound", err)
+	}
+}
+
+func TestApplicationErrors(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	if _, err := client.NewApplication(ctx, &harness.Application{Name: "app"}); err != nil {
+		t.Fatalf("NewApplication: %v", err)
+	}
+
+	cases := []struct {
+		name      string
+		client    *harness.Client
+		app       *harness.Application
+		wantKind  error
+		wantField string
+	}{
+		{"duplicate name", client, &harness.Application{Name: "app"}, harness.ErrConflict, ""},
+		{"empty name", client, &harness.Application{Name: " "}, harness.ErrValidation, "name"},
+		{"wrong API key", harness.NewClient("wrong", server.URL), &harness.Application{Name: "other"}, harness.ErrUnauthorized, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := c.client.NewApplication(ctx, c.app)
+
+			var apiError *harness.APIError
+			if !errors.As(err, &apiError) || !errors.Is(err, c.wantKind) {
+				t.Fatalf("NewApplication returned %v, want an APIError of kind %v", err, c.wantKind)
+			}
+			if apiError.Field != c.wantField {
+				t.Errorf("Field = %q, want %q", apiError.Field, c.wantField)
+			}
+		})
+	}
+}
+
+func TestClientRetriesFailedRequests(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reads recover from gateway errors", func(t *testing.T) {
+		server := harnesstest.NewServer()
+		defer server.Close()
+		client := server.Client()
+
+		app, err := client.NewApplication(ctx, &harness.Application{Name: "app"})
+		if err != nil {
+			t.Fatalf("NewApplication: %v", err)
+		}
+
+		server.FailNext(3, http.StatusServiceUnavailable, 0)
+		if _, err := client.GetApplication(ctx, app.ID); err != nil {
+			t.Errorf("GetApplication after three failures: %v", err)
+		}
+	})
+
+	t.Run("reads give up after the last retry", func(t *testing.T) {
+		server := harnesstest.NewServer()
+		defer server.Close()
+
+		server.FailNext(4, http.StatusBadGateway, 0)
+		if _, err := server.Client().GetApplication(ctx, "app"); !errors.Is(err, harness.ErrServerError) {
+			t.Errorf("GetApplication after four failures returned %v, want ErrServerError", err)
+		}
+	})
+
+	t.Run("creates are not replayed after gateway errors", func(t *testing.T) {
+		server := harnesstest.NewServer()
+		defer server.Close()
+		client := server.Client()
+
+		server.FailNext(1, http.StatusGatewayTimeout, 0)
+		if _, err := client.NewApplication(ctx, &harness.Application{Name: "app"}); !errors.Is(err, harness.ErrServerError) {
+			t.Fatalf("NewApplication returned %v, want ErrServerError", err)
+		}
+		if _, err := client.GetApplicationByName(ctx, "app"); !errors.Is(err, harness.ErrNotFound) {
+			t.Errorf("GetApplicationByName returned %v, want the create to have been sent once", err)
+		}
+	})
+
+	t.Run("creates are replayed when throttled", func(t *testing.T) {
+		server := harnesstest.NewServer()
+		defer server.Close()
+
+		server.FailNext(1, http.StatusTooManyRequests, 0)
+		app, err := server.Client().NewApplication(ctx, &harness.Application{Name: "app"})
+		if err != nil {
+			t.Fatalf("NewApplication after being throttled: %v", err)
+		}
+		if _, ok := server.Entity("application", app.ID); !ok {
+			t.Error("application not stored")
+		}
+	})
+}
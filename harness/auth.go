@@ -0,0 +1,85 @@
+package harness
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// Authenticator adds the credentials of the caller to each request sent to
+// Harness.io.
+type Authenticator interface {
+	Authenticate(req *http.Request) error
+}
+
+// WithAuthenticator replaces the API key given to NewClient with another way
+// of authenticating.
+func WithAuthenticator(auth Authenticator) ClientOption {
+	return func(h *Client) {
+		h.auth = auth
+	}
+}
+
+// APIKey authenticates with a Harness.io API key.
+func APIKey(key string) Authenticator {
+	return apiKeyAuthenticator(key)
+}
+
+type apiKeyAuthenticator string
+
+func (a apiKeyAuthenticator) Authenticate(req *http.Request) error {
+	req.Header.Set("x-api-key", string(a))
+	return nil
+}
+
+// BearerToken authenticates with a bearer token, such as a JWT issued by
+// Harness.io.
+func BearerToken(token string) Authenticator {
+	return bearerAuthenticator(token)
+}
+
+type bearerAuthenticator string
+
+func (a bearerAuthenticator) Authenticate(req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+string(a))
+	return nil
+}
+
+// TokenFile authenticates with a bearer token read from the file at path.
+// The file is read again for every request, so that tokens rotated on disk
+// are picked up without restarting.
+func TokenFile(path string) Authenticator {
+	return tokenFileAuthenticator(path)
+}
+
+type tokenFileAuthenticator string
+
+func (a tokenFileAuthenticator) Authenticate(req *http.Request) error {
+	content, err := ioutil.ReadFile(string(a))
+	if err != nil {
+		return fmt.Errorf("reading Harness.io token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return fmt.Errorf("Harness.io token file %s is empty", string(a))
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// credentials describes the credentials auth sends, for errors rejecting them
+// to point at. It is empty for authenticators of other packages.
+func credentials(auth Authenticator) string {
+	switch a := auth.(type) {
+	case apiKeyAuthenticator:
+		return "API key"
+	case bearerAuthenticator:
+		return "bearer token"
+	case tokenFileAuthenticator:
+		return fmt.Sprintf("token in %s", string(a))
+	}
+	return ""
+}
@@ -0,0 +1,68 @@
+package harness_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+)
+
+func TestTokenFileRotation(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "harness")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+
+	client := server.Client(harness.WithAuthenticator(harness.TokenFile(path)))
+	ctx := context.Background()
+
+	// Tokens are rewritten with the same modification time, as happens when
+	// a rotation falls within the granularity of the file system.
+	modTime := time.Now().Add(-time.Hour)
+	writeToken := func(token string) {
+		if err := ioutil.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := client.GetApplicationByName(ctx, "app"); err == nil {
+		t.Fatal("GetApplicationByName without a token file succeeded")
+	}
+
+	writeToken("expired")
+	_, err = client.GetApplicationByName(ctx, "app")
+	if !errors.Is(err, harness.ErrUnauthorized) {
+		t.Fatalf("GetApplicationByName with an expired token returned %v, want ErrUnauthorized", err)
+	}
+	var apiError *harness.APIError
+	if !errors.As(err, &apiError) || apiError.Credentials != "token in "+path {
+		t.Errorf("GetApplicationByName with an expired token blamed %q, want the token file", apiError.Credentials)
+	}
+
+	writeToken(harnesstest.Token)
+	if _, err := client.GetApplicationByName(ctx, "app"); !errors.Is(err, harness.ErrNotFound) {
+		t.Fatalf("GetApplicationByName with the rotated token returned %v, want ErrNotFound", err)
+	}
+
+	// A token of the same length and modification time is still picked up.
+	expired := []byte(harnesstest.Token)
+	expired[0] = 'X'
+	writeToken(string(expired))
+	if _, err := client.GetApplicationByName(ctx, "app"); !errors.Is(err, harness.ErrUnauthorized) {
+		t.Fatalf("GetApplicationByName with a token of the same length returned %v, want ErrUnauthorized", err)
+	}
+}
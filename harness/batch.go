@@ -0,0 +1,205 @@
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WithBatching coalesces the reads made within window of each other into a
+// single query, aliasing each of them, and sends a batch as soon as it holds
+// maxSize reads. This saves a request per entity when Terraform refreshes
+// many resources at once.
+func WithBatching(window time.Duration, maxSize int) ClientOption {
+	return func(h *Client) {
+		h.batcher = nil
+		if window > 0 && maxSize > 1 {
+			h.batcher = &batcher{h: h, window: window, maxSize: maxSize}
+		}
+	}
+}
+
+// call is a read waiting in a batch for its result.
+type call struct {
+	ctx    context.Context
+	op     *operation
+	values map[string]interface{}
+	alias  string
+
+	result json.RawMessage
+	err    error
+	done   chan struct{}
+}
+
+// batchResponse is a batch's response, holding the result of each call under
+// its alias and the errors of all of them.
+type batchResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []Error                    `json:"errors"`
+}
+
+type batcher struct {
+	h       *Client
+	window  time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	pending []*call
+	timer   *time.Timer
+}
+
+// run queues the operation in the next batch and decodes its result into
+// response, just like an operation sent on its own.
+//
+// A batch is only cancelled once every one of its callers gave up, so a
+// caller giving up does not fail the reads of the others.
+func (b *batcher) run(ctx context.Context, o *operation, values map[string]interface{}, response interface{}) error {
+	c := &call{ctx: ctx, op: o, values: values, done: make(chan struct{})}
+	b.add(c)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.done:
+	}
+
+	if c.err != nil {
+		return c.err
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"data": map[string]json.RawMessage{o.name: c.result},
+	})
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, response)
+}
+
+func (b *batcher) add(c *call) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, c)
+	if len(b.pending) >= b.maxSize {
+		go b.h.runBatch(b.take())
+		return
+	}
+
+	if b.timer == nil {
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+}
+
+func (b *batcher) flush() {
+	b.mu.Lock()
+	calls := b.take()
+	b.mu.Unlock()
+
+	if len(calls) > 0 {
+		b.h.runBatch(calls)
+	}
+}
+
+// take empties the pending batch. It is called with b.mu held.
+func (b *batcher) take() []*call {
+	calls := b.pending
+	b.pending = nil
+
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	return calls
+}
+
+// runBatch sends calls as a single query and hands each of them its result,
+// or the errors whose path starts with its alias. Errors without such a path
+// concern the whole batch and are handed to every call.
+func (h *Client) runBatch(calls []*call) {
+	aliases := make(map[string]bool, len(calls))
+	for i, c := range calls {
+		c.alias = fmt.Sprintf("b%d", i)
+		aliases[c.alias] = true
+	}
+
+	h.logger.Debugf("Sending %d Harness.io reads as a single batch", len(calls))
+
+	ctx, cancel := batchContext(calls)
+	defer cancel()
+
+	response := &batchResponse{}
+	err := h.query(ctx, batchQuery(calls), response)
+
+	aliasErrors := map[string][]Error{}
+	var sharedErrors []Error
+	for _, e := range response.Errors {
+		if alias, ok := errorAlias(e); ok && aliases[alias] {
+			aliasErrors[alias] = append(aliasErrors[alias], e)
+			continue
+		}
+		sharedErrors = append(sharedErrors, e)
+	}
+
+	for _, c := range calls {
+		errs := append(aliasErrors[c.alias], sharedErrors...)
+
+		if err != nil {
+			c.err = err
+		} else if len(errs) > 0 {
+			c.err = h.withCredentials(newGraphQLError(http.StatusOK, c.op.name, unaliasErrors(errs, c.op.name)))
+		} else {
+			c.result = response.Data[c.alias]
+		}
+
+		close(c.done)
+	}
+}
+
+// batchContext returns a context that is done once the contexts of all calls
+// are, or once cancel is called.
+func batchContext(calls []*call) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	remaining := int32(len(calls))
+	for _, c := range calls {
+		go func(callCtx context.Context) {
+			select {
+			case <-callCtx.Done():
+				if atomic.AddInt32(&remaining, -1) == 0 {
+					cancel()
+				}
+			case <-ctx.Done():
+			}
+		}(c.ctx)
+	}
+
+	return ctx, cancel
+}
+
+func errorAlias(e Error) (string, bool) {
+	if len(e.Path) == 0 {
+		return "", false
+	}
+	alias, ok := e.Path[0].(string)
+	return alias, ok
+}
+
+// unaliasErrors rewrites the path of errs to start with the field name rather
+// than the alias, as if the call had been sent on its own.
+func unaliasErrors(errs []Error, name string) []Error {
+	out := make([]Error, 0, len(errs))
+	for _, e := range errs {
+		if len(e.Path) > 0 {
+			e.Path = append([]interface{}{name}, e.Path[1:]...)
+		}
+		out = append(out, e)
+	}
+	return out
+}
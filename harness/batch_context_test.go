@@ -0,0 +1,30 @@
+package harness
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBatchContext(t *testing.T) {
+	first, cancelFirst := context.WithCancel(context.Background())
+	second, cancelSecond := context.WithCancel(context.Background())
+	defer cancelSecond()
+
+	ctx, cancel := batchContext([]*call{{ctx: first}, {ctx: second}})
+	defer cancel()
+
+	cancelFirst()
+	select {
+	case <-ctx.Done():
+		t.Fatal("batch cancelled while one of its callers still waits")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancelSecond()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("batch not cancelled once all of its callers gave up")
+	}
+}
@@ -0,0 +1,171 @@
+package harness_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+)
+
+// batchLogger keeps the size of every batch a client sends.
+type batchLogger struct {
+	mu      sync.Mutex
+	batches []int
+}
+
+func (l *batchLogger) Debugf(format string, args ...interface{}) {
+	if !strings.HasPrefix(format, "Sending %d Harness.io reads as a single batch") {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.batches = append(l.batches, args[0].(int))
+}
+
+func (l *batchLogger) Tracef(format string, args ...interface{}) {}
+
+func (l *batchLogger) sizes() []int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]int(nil), l.batches...)
+}
+
+// getApplications gets the applications of ids at once, returning their
+// names or errors in the same order.
+func getApplications(ctx context.Context, client *harness.Client, ids ...string) ([]string, []error) {
+	names := make([]string, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			app, err := client.GetApplication(ctx, id)
+			if err == nil {
+				names[i] = app.Name
+			}
+			errs[i] = err
+		}(i, id)
+	}
+	wg.Wait()
+
+	return names, errs
+}
+
+func newApplications(t *testing.T, server *harnesstest.Server, count int) []string {
+	t.Helper()
+
+	ids := make([]string, count)
+	for i := range ids {
+		app, err := server.Client().NewApplication(context.Background(), &harness.Application{Name: fmt.Sprintf("app-%d", i)})
+		if err != nil {
+			t.Fatalf("NewApplication: %v", err)
+		}
+		ids[i] = app.ID
+	}
+	return ids
+}
+
+func TestBatchFlushesWhenFull(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	ids := newApplications(t, server, 6)
+
+	logger := &batchLogger{}
+	client := server.Client(harness.WithBatching(time.Hour, 3), harness.WithLogger(logger))
+
+	names, errs := getApplications(context.Background(), client, ids...)
+	for i := range ids {
+		if errs[i] != nil {
+			t.Fatalf("GetApplication(%s): %v", ids[i], errs[i])
+		}
+		if want := fmt.Sprintf("app-%d", i); names[i] != want {
+			t.Errorf("GetApplication(%s) = %s, want %s", ids[i], names[i], want)
+		}
+	}
+
+	if got := fmt.Sprint(logger.sizes()); got != "[3 3]" {
+		t.Errorf("sent batches of %s, want [3 3]", got)
+	}
+}
+
+func TestBatchFlushesAfterWindow(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	ids := newApplications(t, server, 2)
+
+	logger := &batchLogger{}
+	client := server.Client(harness.WithBatching(20*time.Millisecond, 100), harness.WithLogger(logger))
+
+	start := time.Now()
+	_, errs := getApplications(context.Background(), client, ids...)
+	for i := range ids {
+		if errs[i] != nil {
+			t.Fatalf("GetApplication(%s): %v", ids[i], errs[i])
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("batch sent after %s, want after the 20ms window", elapsed)
+	}
+	if got := fmt.Sprint(logger.sizes()); got != "[2]" {
+		t.Errorf("sent batches of %s, want [2]", got)
+	}
+}
+
+func TestBatchHandsErrorsToTheirCall(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	ids := newApplications(t, server, 2)
+
+	logger := &batchLogger{}
+	client := server.Client(harness.WithBatching(time.Hour, 3), harness.WithLogger(logger))
+
+	names, errs := getApplications(context.Background(), client, ids[0], "missing", ids[1])
+
+	if errs[0] != nil || errs[2] != nil {
+		t.Fatalf("GetApplication of existing applications returned %v and %v", errs[0], errs[2])
+	}
+	if names[0] != "app-0" || names[2] != "app-1" {
+		t.Errorf("GetApplication of existing applications = %s and %s, want app-0 and app-1", names[0], names[2])
+	}
+	if !errors.Is(errs[1], harness.ErrUnauthorized) {
+		t.Errorf("GetApplication(missing) returned %v, want ErrUnauthorized", errs[1])
+	}
+	if got := fmt.Sprint(logger.sizes()); got != "[3]" {
+		t.Errorf("sent batches of %s, want [3]", got)
+	}
+}
+
+func TestBatchOutlivesCallersGivingUp(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	ids := newApplications(t, server, 2)
+
+	client := server.Client(harness.WithBatching(20*time.Millisecond, 100))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if _, err := client.GetApplication(ctx, ids[0]); err != context.Canceled {
+			t.Errorf("GetApplication with a cancelled context returned %v, want %v", err, context.Canceled)
+		}
+	}()
+
+	if app, err := client.GetApplication(context.Background(), ids[1]); err != nil || app.Name != "app-1" {
+		t.Errorf("GetApplication = %v, %v, want app-1", app, err)
+	}
+	wg.Wait()
+}
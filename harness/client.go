@@ -2,53 +2,304 @@ package harness
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultTimeout     = 60 * time.Second
+	defaultMaxRetries  = 5
+	defaultMinBackoff  = 500 * time.Millisecond
+	defaultMaxBackoff  = 30 * time.Second
+	maxRetryAfterDelay = 2 * time.Minute
 )
 
 type Client struct {
-	apiKey   string
-	endpoint string
+	auth       Authenticator
+	endpoint   string
+	httpClient *http.Client
+
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	logger  Logger
+	limiter *limiter
+	batcher *batcher
+}
+
+// ClientOption configures optional behaviour of a Client.
+type ClientOption func(*Client)
+
+// WithHTTPClient replaces the http.Client used to talk to Harness.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(h *Client) {
+		h.httpClient = httpClient
+	}
+}
+
+// WithRetry configures how many times a failed request is retried and the
+// bounds of the exponential backoff between attempts.
+func WithRetry(maxRetries int, minBackoff time.Duration, maxBackoff time.Duration) ClientOption {
+	return func(h *Client) {
+		h.maxRetries = maxRetries
+		h.minBackoff = minBackoff
+		h.maxBackoff = maxBackoff
+	}
 }
 
-func NewClient(apiKey string, endpoint string) *Client {
-	return &Client{
-		apiKey:   apiKey,
-		endpoint: endpoint,
+func NewClient(apiKey string, endpoint string, opts ...ClientOption) *Client {
+	h := &Client{
+		auth:       APIKey(apiKey),
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		maxRetries: defaultMaxRetries,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+		logger:     nopLogger{},
+		limiter:    &limiter{},
 	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
 }
 
 type GraphQLQuery struct {
 	OperationName string      `json:"operationName,omitempty"`
 	Query         string      `json:"query"`
 	Variables     interface{} `json:"variables"`
-}
 
-type Error struct {
-	Message string   `json:"message"`
-	Path    []string `json:"path`
+	// nonIdempotent marks mutations that must not be replayed once Harness
+	// may have processed them, such as creates and deletes: a replayed
+	// delete that already went through fails as not found. These are only
+	// retried when Harness explicitly throttled the request.
+	nonIdempotent bool
+
+	// partialErrors leaves GraphQL errors in the decoded response rather than
+	// failing the whole query, for batches to assign them to their callers.
+	partialErrors bool
 }
 
-func (h *Client) query(q *GraphQLQuery, response interface{}) error {
+func (h *Client) query(ctx context.Context, q *GraphQLQuery, response interface{}) error {
 	queryBytes, err := json.Marshal(q)
 	if err != nil {
 		return err
 	}
 
-	req, _ := http.NewRequest("POST", h.endpoint, bytes.NewBuffer(queryBytes))
-	req.Header.Set("x-api-key", h.apiKey)
-	req.Header.Set("content-type", "application/json")
+	return h.retry(ctx, q.OperationName, q.nonIdempotent, func() error {
+		return h.do(ctx, q, queryBytes, response)
+	})
+}
+
+// retry makes attempts at the operation called name until one succeeds,
+// fails in a way not worth retrying or the client runs out of retries.
+func (h *Client) retry(ctx context.Context, name string, nonIdempotent bool, attempt func() error) error {
+	for i := 0; ; i++ {
+		err := attempt()
+		if err == nil {
+			return nil
+		}
+
+		if i >= h.maxRetries || !shouldRetry(nonIdempotent, err) {
+			return h.withCredentials(err)
+		}
+
+		var retryAfter time.Duration
+		if apiError, ok := err.(*APIError); ok {
+			retryAfter = apiError.retryAfter
+		}
+
+		delay := h.backoff(i, retryAfter)
+		h.logger.Debugf("Retrying Harness.io operation %s in %s: %v", name, delay, err)
 
-	res, err := http.DefaultClient.Do(req)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// withCredentials records the credentials of the client on errors rejecting
+// them.
+func (h *Client) withCredentials(err error) error {
+	if apiError, ok := err.(*APIError); ok && (apiError.Kind == ErrUnauthorized || apiError.Kind == ErrForbidden) {
+		apiError.Credentials = credentials(h.auth)
+	}
+	return err
+}
+
+// transientError is returned by send when a request failed before Harness.io
+// produced a response, in a way that may succeed when repeated.
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+// graphQLResponse is used to look at the errors of a response before decoding
+// its data into the caller's type.
+type graphQLResponse struct {
+	Errors []Error `json:"errors"`
+}
+
+// send sends a request for the operation called name within the limits of
+// the client, returning the response along with its body. requestBody is
+// only used for logging.
+func (h *Client) send(ctx context.Context, name string, req *http.Request, requestBody []byte) (*http.Response, []byte, error) {
+	release, waited, err := h.limiter.acquire(ctx)
+	if err != nil {
+		h.logger.Debugf("Harness.io operation %s gave up after waiting %s for the rate limiter: %v", name, waited, err)
+		return nil, nil, err
+	}
+	defer release()
+
+	if err := h.auth.Authenticate(req); err != nil {
+		return nil, nil, err
+	}
+
+	h.logger.Tracef("Harness.io operation %s request: %s", name, redact(requestBody))
+
+	start := time.Now()
+	res, err := h.httpClient.Do(req)
+	if err != nil {
+		h.logger.Debugf("Harness.io operation %s failed after %s, having waited %s for the rate limiter: %v", name, time.Since(start), waited, err)
+		if ctx.Err() != nil {
+			return nil, nil, ctx.Err()
+		}
+		return nil, nil, &transientError{err: err}
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	h.logger.Debugf("Harness.io operation %s: %s in %s, having waited %s for the rate limiter", name, res.Status, time.Since(start), waited)
+	if err != nil {
+		return nil, nil, &transientError{err: err}
+	}
+	h.logger.Tracef("Harness.io operation %s response: %s", name, redact(body))
+
+	return res, body, nil
+}
+
+// gatewayError returns the error of a response rejected by the gateway in
+// front of Harness.io in a way that may clear up by itself, if it is one.
+func gatewayError(res *http.Response, operation string) error {
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		apiError := newStatusError(res.StatusCode, operation)
+		apiError.retryAfter = parseRetryAfter(res.Header.Get("Retry-After"))
+		return apiError
+	}
+	return nil
+}
+
+func (h *Client) do(ctx context.Context, q *GraphQLQuery, queryBytes []byte, response interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", h.endpoint, bytes.NewReader(queryBytes))
 	if err != nil {
 		return err
 	}
+	req.Header.Set("content-type", "application/json")
 
-	decoder := json.NewDecoder(res.Body)
-	err = decoder.Decode(&response)
+	res, body, err := h.send(ctx, q.OperationName, req, queryBytes)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if err := gatewayError(res, q.OperationName); err != nil {
+		return err
+	}
+
+	envelope := &graphQLResponse{}
+	if err := json.Unmarshal(body, envelope); err != nil {
+		if res.StatusCode >= 400 {
+			return newStatusError(res.StatusCode, q.OperationName)
+		}
+		return fmt.Errorf("decoding Harness.io response: %w", err)
+	}
+
+	if len(envelope.Errors) > 0 && (!q.partialErrors || res.StatusCode >= 400) {
+		return newGraphQLError(res.StatusCode, q.OperationName, envelope.Errors)
+	}
+
+	if res.StatusCode >= 400 {
+		return newStatusError(res.StatusCode, q.OperationName)
+	}
+
+	return json.Unmarshal(body, response)
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating. Requests
+// that are not idempotent, such as creates, are only repeated when Harness.io
+// explicitly throttled them.
+func shouldRetry(nonIdempotent bool, err error) bool {
+	switch e := err.(type) {
+	case *transientError:
+		return !nonIdempotent
+	case *APIError:
+		if e.StatusCode == http.StatusTooManyRequests {
+			return true
+		}
+		return e.retryable() && !nonIdempotent
+	}
+
+	return false
+}
+
+// backoff returns the delay before the next attempt using jittered
+// exponential backoff, unless Harness told us how long to wait.
+func (h *Client) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	delay := h.minBackoff << uint(attempt)
+	if delay <= 0 || delay > h.maxBackoff {
+		delay = h.maxBackoff
+	}
+	if delay <= 1 {
+		return delay
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)))
+}
+
+// parseRetryAfter understands both the delay-seconds and HTTP-date forms of
+// the Retry-After header.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		delay = time.Until(date)
+	}
+
+	if delay < 0 {
+		return 0
+	}
+	if delay > maxRetryAfterDelay {
+		return maxRetryAfterDelay
+	}
+
+	return delay
 }
@@ -0,0 +1,276 @@
+package harness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestShouldRetry(t *testing.T) {
+	cases := []struct {
+		name          string
+		nonIdempotent bool
+		err           error
+		want          bool
+	}{
+		{"connection failure", false, &transientError{err: errors.New("connection reset")}, true},
+		{"connection failure of a create", true, &transientError{err: errors.New("connection reset")}, false},
+		{"throttled", false, newStatusError(http.StatusTooManyRequests, "q"), true},
+		{"throttled create", true, newStatusError(http.StatusTooManyRequests, "q"), true},
+		{"bad gateway", false, newStatusError(http.StatusBadGateway, "q"), true},
+		{"unavailable", false, newStatusError(http.StatusServiceUnavailable, "q"), true},
+		{"unavailable create", true, newStatusError(http.StatusServiceUnavailable, "q"), false},
+		{"gateway timeout create", true, newStatusError(http.StatusGatewayTimeout, "q"), false},
+		{"internal error", false, newStatusError(http.StatusInternalServerError, "q"), false},
+		{"bad request", false, newStatusError(http.StatusBadRequest, "q"), false},
+		{"not found", false, newNotFoundError("application"), false},
+		{"cancelled", false, context.Canceled, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := shouldRetry(c.nonIdempotent, c.err); got != c.want {
+				t.Errorf("shouldRetry(%v, %v) = %v, want %v", c.nonIdempotent, c.err, got, c.want)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	h := NewClient("", "", WithRetry(5, 100*time.Millisecond, time.Second))
+
+	cases := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{1, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond, 800 * time.Millisecond},
+		{4, 500 * time.Millisecond, time.Second},
+		{10, 500 * time.Millisecond, time.Second},
+		{70, 500 * time.Millisecond, time.Second},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("attempt %d", c.attempt), func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				if got := h.backoff(c.attempt, 0); got < c.min || got >= c.max {
+					t.Fatalf("backoff(%d) = %s, want within [%s, %s)", c.attempt, got, c.min, c.max)
+				}
+			}
+		})
+	}
+
+	t.Run("retry after", func(t *testing.T) {
+		if got := h.backoff(0, 5*time.Second); got != 5*time.Second {
+			t.Errorf("backoff with Retry-After = %s, want 5s", got)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    string
+		min, max time.Duration
+	}{
+		{"missing", "", 0, 0},
+		{"seconds", "3", 3 * time.Second, 3 * time.Second},
+		{"negative seconds", "-3", 0, 0},
+		{"garbage", "soon", 0, 0},
+		{"capped seconds", "3600", maxRetryAfterDelay, maxRetryAfterDelay},
+		{"date", time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), 8 * time.Second, 10 * time.Second},
+		{"past date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, 0},
+		{"capped date", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), maxRetryAfterDelay, maxRetryAfterDelay},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := parseRetryAfter(c.value); got < c.min || got > c.max {
+				t.Errorf("parseRetryAfter(%q) = %s, want within [%s, %s]", c.value, got, c.min, c.max)
+			}
+		})
+	}
+}
+
+func TestQueryRetries(t *testing.T) {
+	cases := []struct {
+		name          string
+		nonIdempotent bool
+		statuses      []int
+		wantAttempts  int32
+		wantErr       error
+	}{
+		{"query recovers", false, []int{http.StatusServiceUnavailable, http.StatusBadGateway}, 3, nil},
+		{"query runs out of retries", false, []int{503, 503, 503, 503, 503}, 3, ErrServerError},
+		{"query not retried on internal error", false, []int{http.StatusInternalServerError}, 1, ErrServerError},
+		{"query not retried on bad request", false, []int{http.StatusBadRequest}, 1, ErrValidation},
+		{"create not retried on unavailable", true, []int{http.StatusServiceUnavailable}, 1, ErrServerError},
+		{"create not retried on gateway timeout", true, []int{http.StatusGatewayTimeout}, 1, ErrServerError},
+		{"create retried when throttled", true, []int{http.StatusTooManyRequests}, 2, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var attempts int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := int(atomic.AddInt32(&attempts, 1)) - 1
+				if i < len(c.statuses) {
+					http.Error(w, http.StatusText(c.statuses[i]), c.statuses[i])
+					return
+				}
+				w.Write([]byte(`{"data":{"application":{"id":"app"}}}`))
+			}))
+			defer server.Close()
+
+			h := NewClient("key", server.URL, WithRetry(2, time.Millisecond, time.Millisecond))
+			err := h.query(context.Background(), &GraphQLQuery{OperationName: "q", nonIdempotent: c.nonIdempotent}, &struct{}{})
+
+			if c.wantErr == nil && err != nil {
+				t.Fatalf("query failed: %v", err)
+			}
+			if c.wantErr != nil && !errors.Is(err, c.wantErr) {
+				t.Fatalf("query returned %v, want %v", err, c.wantErr)
+			}
+			if got := atomic.LoadInt32(&attempts); got != c.wantAttempts {
+				t.Errorf("made %d attempts, want %d", got, c.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestQueryReadsRetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "7")
+		http.Error(w, "slow down", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	h := NewClient("key", server.URL, WithRetry(0, time.Millisecond, time.Millisecond))
+	err := h.query(context.Background(), &GraphQLQuery{OperationName: "q"}, &struct{}{})
+
+	var apiError *APIError
+	if !errors.As(err, &apiError) || !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("query returned %v, want a rate limited APIError", err)
+	}
+	if apiError.retryAfter != 7*time.Second {
+		t.Errorf("retryAfter = %s, want 7s", apiError.retryAfter)
+	}
+}
+
+func TestRetryWaitsRetryAfter(t *testing.T) {
+	h := NewClient("", "", WithRetry(1, time.Millisecond, time.Millisecond))
+
+	throttled := newStatusError(http.StatusTooManyRequests, "q")
+	throttled.retryAfter = 50 * time.Millisecond
+
+	attempts := 0
+	start := time.Now()
+	err := h.retry(context.Background(), "q", true, func() error {
+		attempts++
+		if attempts == 1 {
+			return throttled
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("retry failed: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("made %d attempts, want 2", attempts)
+	}
+	if elapsed := time.Since(start); elapsed < throttled.retryAfter {
+		t.Errorf("retried after %s, want at least %s", elapsed, throttled.retryAfter)
+	}
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	timeout, cancelTimeout := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelTimeout()
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	cases := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"deadline", timeout, context.DeadlineExceeded},
+		{"cancelled", cancelled, context.Canceled},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h := NewClient("", "", WithRetry(5, time.Minute, time.Minute))
+
+			attempts := 0
+			start := time.Now()
+			err := h.retry(c.ctx, "q", false, func() error {
+				attempts++
+				return newStatusError(http.StatusServiceUnavailable, "q")
+			})
+
+			if err != c.want {
+				t.Errorf("retry returned %v, want %v", err, c.want)
+			}
+			if attempts != 1 {
+				t.Errorf("made %d attempts, want 1", attempts)
+			}
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Errorf("retry returned after %s, want as soon as the context was done", elapsed)
+			}
+		})
+	}
+}
+
+func TestDeletesRetriedOnlyWhenThrottled(t *testing.T) {
+	deletes := []struct {
+		name string
+		run  func(h *Client) error
+	}{
+		{"graphql", func(h *Client) error {
+			_, err := h.deleteApplication(context.Background(), &DeleteApplicationInput{ApplicationID: "app"})
+			return err
+		}},
+		{"yaml", func(h *Client) error {
+			return h.YAML().Delete(context.Background(), "Setup/Applications/app/Index.yaml")
+		}},
+	}
+
+	cases := []struct {
+		status       int
+		wantAttempts int32
+	}{
+		{http.StatusServiceUnavailable, 1},
+		{http.StatusGatewayTimeout, 1},
+		{http.StatusTooManyRequests, 3},
+	}
+
+	for _, d := range deletes {
+		for _, c := range cases {
+			t.Run(fmt.Sprintf("%s %d", d.name, c.status), func(t *testing.T) {
+				var attempts int32
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					atomic.AddInt32(&attempts, 1)
+					http.Error(w, http.StatusText(c.status), c.status)
+				}))
+				defer server.Close()
+
+				h := NewClient("key", server.URL+"/gateway/api/graphql", WithRetry(2, time.Millisecond, time.Millisecond))
+				if err := d.run(h); err == nil {
+					t.Fatal("delete succeeded, want it to fail")
+				}
+				if got := atomic.LoadInt32(&attempts); got != c.wantAttempts {
+					t.Errorf("made %d attempts, want %d", got, c.wantAttempts)
+				}
+			})
+		}
+	}
+}
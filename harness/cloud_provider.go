@@ -1,43 +1,89 @@
 package harness
 
-type CloudProvider struct {
-	ID          string
-	Name        string
-	Description string
-}
+import (
+	"context"
+)
 
-type CloudProviderWrapper struct {
-	CloudProvider *CloudProvider
-}
+// GetCloudProvider fetches a cloud provider of any type by its id.
+func (h *Client) GetCloudProvider(ctx context.Context, id string) (*CloudProvider, error) {
+	cp, err := h.cloudProvider(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if cp == nil {
+		return nil, newNotFoundError("cloud provider")
+	}
 
-type CreateCloudProviderWrapper struct {
-	CreateCloudProvider *CloudProviderWrapper
+	return cp, nil
 }
 
-type DeleteCloudProviderWrapper struct {
-	DeleteCloudProvider *CloudProviderWrapper
+// DeleteCloudProvider deletes a cloud provider of any type by its id.
+func (h *Client) DeleteCloudProvider(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io cloud provider with id '%s'", id)
+
+	_, err := h.deleteCloudProvider(ctx, &DeleteCloudProviderInput{
+		CloudProviderID: id,
+	})
+
+	return err
 }
 
-type UpdateCloudProviderWrapper struct {
-	UpdateCloudProvider *CloudProviderWrapper
+// ListCloudProvidersOptions narrows down the cloud providers returned by
+// ListCloudProviders.
+type ListCloudProvidersOptions struct {
+	ListOptions
+
+	// Types only keeps cloud providers of the given types, when set.
+	Types []CloudProviderType
 }
 
-type CreateCloudProviderResponse struct {
-	Errors []Error
-	Data   *CreateCloudProviderWrapper
+func (o *ListCloudProvidersOptions) filters() []*CloudProviderFilter {
+	if o == nil || len(o.Types) == 0 {
+		return nil
+	}
+
+	return []*CloudProviderFilter{{
+		CloudProviderType: &CloudProviderTypeFilter{
+			Operator: EnumOperatorIn,
+			Values:   o.Types,
+		},
+	}}
 }
 
-type UpdateCloudProviderResponse struct {
-	Errors []Error
-	Data   *UpdateCloudProviderWrapper
+// CloudProviderIterator walks the cloud providers of the account, see
+// ListCloudProviders.
+type CloudProviderIterator struct {
+	pager
+	page []*CloudProvider
 }
 
-type DeleteCloudProviderApiResponse struct {
-	Errors []Error
-	Data   *DeleteCloudProviderWrapper
+// CloudProvider returns the cloud provider the iterator is positioned at.
+func (it *CloudProviderIterator) CloudProvider() *CloudProvider {
+	return it.page[it.index]
 }
 
-type GetCloudProviderResponse struct {
-	Errors []Error
-	Data   *CloudProviderWrapper
+// ListCloudProviders returns an iterator over the cloud providers of the
+// account, fetching them a page at a time as the iterator advances.
+func (h *Client) ListCloudProviders(opts *ListCloudProvidersOptions) *CloudProviderIterator {
+	var listOpts *ListOptions
+	if opts != nil {
+		listOpts = &opts.ListOptions
+	}
+	filters := opts.filters()
+
+	it := &CloudProviderIterator{}
+	it.pager = newPager(listOpts.pageSize(), func(ctx context.Context, limit int, offset int) (int, *PageInfo, error) {
+		h.logger.Debugf("Listing Harness.io cloud providers from offset %d", offset)
+
+		conn, err := h.cloudProviders(ctx, limit, Int(offset), filters)
+		if err != nil || conn == nil {
+			return 0, nil, err
+		}
+
+		it.page = conn.Nodes
+		return len(conn.Nodes), conn.PageInfo, nil
+	})
+
+	return it
 }
@@ -0,0 +1,111 @@
+package harness
+
+import "context"
+
+// CloudProviderAwsCredentials are the credentials of an AWS cloud provider.
+// AccessKey or AccessKeySecretID and SecretKeySecretID are used with MANUAL
+// credentials, DelegateSelectors with EC2_IAM and IRSA ones. The role of
+// CrossAccountRoleARN, when set, is assumed with any of them.
+type CloudProviderAwsCredentials struct {
+	Type                AwsCredentialsType
+	AccessKey           string
+	AccessKeySecretID   string
+	SecretKeySecretID   string
+	DelegateSelectors   []string
+	CrossAccountRoleARN string
+	ExternalID          string
+}
+
+func (h *Client) GetCloudProviderAws(ctx context.Context, id string) (*CloudProvider, error) {
+	return h.GetCloudProvider(ctx, id)
+}
+
+func (h *Client) NewCloudProviderAws(ctx context.Context, name string, credentials *CloudProviderAwsCredentials, usageScope *UsageScope) (*CloudProvider, error) {
+	input := &AwsCloudProviderInput{
+		Name:            name,
+		CredentialsType: credentials.Type,
+		UsageScope:      usageScopeInput(usageScope),
+	}
+	input.ManualCredentials, input.Ec2IAMCredentials, input.IrsaCredentials = credentials.inputs()
+	input.CrossAccountAttributes = credentials.crossAccountAttributes()
+
+	payload, err := h.createCloudProvider(ctx, &CreateCloudProviderInput{
+		CloudProviderType: CloudProviderTypeAWS,
+		AWSCloudProvider:  input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.CloudProvider == nil {
+		return nil, newNotFoundError("cloud provider")
+	}
+
+	return payload.CloudProvider, nil
+}
+
+func (h *Client) DeleteCloudProviderAws(ctx context.Context, id string) error {
+	return h.DeleteCloudProvider(ctx, id)
+}
+
+func (h *Client) UpdateCloudProviderAws(ctx context.Context, id string, name string, credentials *CloudProviderAwsCredentials, usageScope *UsageScope) (*CloudProvider, error) {
+	input := &UpdateAwsCloudProviderInput{
+		Name:            String(name),
+		CredentialsType: credentials.Type,
+		UsageScope:      usageScopeInput(usageScope),
+	}
+	input.ManualCredentials, input.Ec2IAMCredentials, input.IrsaCredentials = credentials.inputs()
+	input.CrossAccountAttributes = credentials.crossAccountAttributes()
+
+	payload, err := h.updateCloudProvider(ctx, &UpdateCloudProviderInput{
+		CloudProviderType: CloudProviderTypeAWS,
+		CloudProviderID:   id,
+		AWSCloudProvider:  input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.CloudProvider == nil {
+		return nil, newNotFoundError("cloud provider")
+	}
+
+	return payload.CloudProvider, nil
+}
+
+// inputs returns the manual, EC2 IAM and IRSA credentials, only the one
+// matching the type of the credentials being set.
+func (c *CloudProviderAwsCredentials) inputs() (*AwsManualCredentials, *AwsDelegateCredentials, *AwsDelegateCredentials) {
+	switch c.Type {
+	case AwsCredentialsTypeManual:
+		manual := &AwsManualCredentials{SecretKeySecretID: c.SecretKeySecretID}
+		if c.AccessKeySecretID != "" {
+			manual.AccessKeySecretID = String(c.AccessKeySecretID)
+		} else {
+			manual.AccessKey = String(c.AccessKey)
+		}
+		return manual, nil, nil
+	case AwsCredentialsTypeEc2IAM:
+		return nil, &AwsDelegateCredentials{DelegateSelectors: c.DelegateSelectors}, nil
+	case AwsCredentialsTypeIrsa:
+		return nil, nil, &AwsDelegateCredentials{DelegateSelectors: c.DelegateSelectors}
+	}
+
+	return nil, nil, nil
+}
+
+func (c *CloudProviderAwsCredentials) crossAccountAttributes() *AwsCrossAccountAttributes {
+	if c.CrossAccountRoleARN == "" {
+		return nil
+	}
+
+	attributes := &AwsCrossAccountAttributes{
+		AssumeCrossAccountRole: Bool(true),
+		CrossAccountRoleARN:    c.CrossAccountRoleARN,
+	}
+	if c.ExternalID != "" {
+		attributes.ExternalID = String(c.ExternalID)
+	}
+
+	return attributes
+}
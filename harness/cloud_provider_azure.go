@@ -1,140 +1,53 @@
 package harness
 
-import (
-	"fmt"
-)
+import "context"
 
-func (h *Client) GetCloudProviderAzure(id string) (*CloudProvider, error) {
-	query := `query { cloudProvider(cloudProviderId: "%s") { id name } }`
-	graphQLQuery := &GraphQLQuery{
-		Query: fmt.Sprintf(query, id),
-	}
-
-	response := &GetCloudProviderResponse{}
-	err := h.query(graphQLQuery, response)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(response.Errors) > 0 {
-		return nil, fmt.Errorf("Error retrieving cloud provider: %#v", response.Errors)
-	}
-
-	return response.Data.CloudProvider, nil
+func (h *Client) GetCloudProviderAzure(ctx context.Context, id string) (*CloudProvider, error) {
+	return h.GetCloudProvider(ctx, id)
 }
 
-func (h *Client) NewCloudProviderAzure(name string, secretId string, clientId string, tenantId string) (*CloudProvider, error) {
-	query := `mutation($cloudProvider: CreateCloudProviderInput!) {
-		createCloudProvider(input: $cloudProvider){
-			cloudProvider {
-				id
-				name
-				description
-			}
-		}
-	}
-	`
-
-	graphQLQuery := &GraphQLQuery{
-		Query: query,
-		Variables: map[string]interface{}{
-			"cloudProvider": map[string]interface{}{
-				"cloudProviderType": "AZURE",
-				"azureCloudProvider": map[string]interface{}{
-					"name":        name,
-					"clientId":    clientId,
-					"tenantId":    tenantId,
-					"keySecretId": secretId,
-				},
-			},
+func (h *Client) NewCloudProviderAzure(ctx context.Context, name string, secretId string, clientId string, tenantId string) (*CloudProvider, error) {
+	payload, err := h.createCloudProvider(ctx, &CreateCloudProviderInput{
+		CloudProviderType: CloudProviderTypeAzure,
+		AzureCloudProvider: &AzureCloudProviderInput{
+			Name:        name,
+			ClientID:    String(clientId),
+			TenantID:    String(tenantId),
+			KeySecretID: String(secretId),
 		},
-	}
-
-	response := &CreateCloudProviderResponse{}
-	err := h.query(graphQLQuery, response)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(response.Errors) > 0 {
-		return nil, fmt.Errorf("Error creating cloud provider: %#v", response.Errors)
+	if payload == nil || payload.CloudProvider == nil {
+		return nil, newNotFoundError("cloud provider")
 	}
 
-	return response.Data.CreateCloudProvider.CloudProvider, nil
+	return payload.CloudProvider, nil
 }
 
-func (h *Client) DeleteCloudProviderAzure(id string) error {
-	fmt.Printf("Deleting a Harness.io cloud provider with id '%s'", id)
-
-	query := `mutation($cp: DeleteCloudProviderInput!){
-		deleteCloudProvider(input: $cp) {
-			clientMutationId
-		}
-	}
-	`
+func (h *Client) DeleteCloudProviderAzure(ctx context.Context, id string) error {
+	return h.DeleteCloudProvider(ctx, id)
+}
 
-	graphQlQuery := &GraphQLQuery{
-		Query: query,
-		Variables: map[string]interface{}{
-			"cp": map[string]string{
-				"cloudProviderId": id,
-			},
+func (h *Client) UpdateCloudProviderAzure(ctx context.Context, id string, name string, clientId string, tenantId string) (*CloudProvider, error) {
+	payload, err := h.updateCloudProvider(ctx, &UpdateCloudProviderInput{
+		CloudProviderType: CloudProviderTypeAzure,
+		CloudProviderID:   id,
+		AzureCloudProvider: &UpdateAzureCloudProviderInput{
+			Name:     String(name),
+			ClientID: String(clientId),
+			TenantID: String(tenantId),
 		},
-	}
-
-	apiResponse := &DeleteCloudProviderApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
-
+	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if len(apiResponse.Errors) > 0 {
-		return fmt.Errorf("Errors: %#v", apiResponse.Errors)
+	if payload == nil || payload.CloudProvider == nil {
+		return nil, newNotFoundError("cloud provider")
 	}
 
-	return nil
-}
-
-func (h *Client) UpdateCloudProviderAzure(id string, name string, clientId string, tenantId string) (*CloudProvider, error) {
-	{
-		query := `mutation($cloudProvider: UpdateCloudProviderInput!) {
-			updateCloudProvider(input: $cloudProvider){
-				clientMutationId
-				cloudProvider {
-					id
-					name
-					description
-				}
-			}
-		}
-		`
-
-		graphQLQuery := &GraphQLQuery{
-			Query: query,
-			Variables: map[string]interface{}{
-				"cloudProvider": map[string]interface{}{
-					"cloudProviderType": "AZURE",
-					"cloudProviderId":   id,
-					"azureCloudProvider": map[string]interface{}{
-						"name":     name,
-						"clientId": clientId,
-						"tenantId": tenantId,
-					},
-				},
-			},
-		}
-
-		response := &UpdateCloudProviderResponse{}
-		err := h.query(graphQLQuery, response)
-		if err != nil {
-			return nil, err
-		}
-
-		if len(response.Errors) > 0 {
-			return nil, fmt.Errorf("Error creating cloud provider: %#v", response.Errors)
-		}
-
-		return response.Data.UpdateCloudProvider.CloudProvider, nil
-	}
+	return payload.CloudProvider, nil
 }
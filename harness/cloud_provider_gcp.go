@@ -0,0 +1,67 @@
+package harness
+
+import "context"
+
+func (h *Client) GetCloudProviderGcp(ctx context.Context, id string) (*CloudProvider, error) {
+	return h.GetCloudProvider(ctx, id)
+}
+
+// NewCloudProviderGcp creates a GCP cloud provider authenticating with the
+// service account key held in the encrypted file of keySecretID or, when
+// delegateSelectors are given, as the service account of those delegates.
+func (h *Client) NewCloudProviderGcp(ctx context.Context, name string, keySecretID string, delegateSelectors []string, skipValidation bool, usageScope *UsageScope) (*CloudProvider, error) {
+	input := &GcpCloudProviderInput{
+		Name:                 name,
+		UseDelegateSelectors: Bool(len(delegateSelectors) > 0),
+		DelegateSelectors:    delegateSelectors,
+		SkipValidation:       Bool(skipValidation),
+		UsageScope:           usageScopeInput(usageScope),
+	}
+	if keySecretID != "" {
+		input.ServiceAccountKeySecretID = String(keySecretID)
+	}
+
+	payload, err := h.createCloudProvider(ctx, &CreateCloudProviderInput{
+		CloudProviderType: CloudProviderTypeGCP,
+		GCPCloudProvider:  input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.CloudProvider == nil {
+		return nil, newNotFoundError("cloud provider")
+	}
+
+	return payload.CloudProvider, nil
+}
+
+func (h *Client) DeleteCloudProviderGcp(ctx context.Context, id string) error {
+	return h.DeleteCloudProvider(ctx, id)
+}
+
+// UpdateCloudProviderGcp updates a GCP cloud provider. keySecretID is always
+// sent, so that switching to delegateSelectors clears the key.
+func (h *Client) UpdateCloudProviderGcp(ctx context.Context, id string, name string, keySecretID string, delegateSelectors []string, skipValidation bool, usageScope *UsageScope) (*CloudProvider, error) {
+	payload, err := h.updateCloudProvider(ctx, &UpdateCloudProviderInput{
+		CloudProviderType: CloudProviderTypeGCP,
+		CloudProviderID:   id,
+		GCPCloudProvider: &UpdateGcpCloudProviderInput{
+			Name:                      String(name),
+			UseDelegateSelectors:      Bool(len(delegateSelectors) > 0),
+			DelegateSelectors:         delegateSelectors,
+			ServiceAccountKeySecretID: String(keySecretID),
+			SkipValidation:            Bool(skipValidation),
+			UsageScope:                usageScopeInput(usageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.CloudProvider == nil {
+		return nil, newNotFoundError("cloud provider")
+	}
+
+	return payload.CloudProvider, nil
+}
@@ -0,0 +1,53 @@
+package harness_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+)
+
+func TestCloudProviderGcpSwitchToDelegateSelectors(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	key, err := client.NewEncryptedFile(ctx, &harness.EncryptedFileSecret{
+		Name:            "service-account.json",
+		Content:         []byte(`{"type":"service_account","project_id":"my-project"}`),
+		SecretManagerID: "builtin",
+	})
+	if err != nil {
+		t.Fatalf("NewEncryptedFile: %v", err)
+	}
+
+	cp, err := client.NewCloudProviderGcp(ctx, "gcp", key.ID, nil, false, nil)
+	if err != nil {
+		t.Fatalf("NewCloudProviderGcp: %v", err)
+	}
+	if stored, _ := server.Entity("cloudProvider", cp.ID); stored["serviceAccountKeySecretId"] != key.ID {
+		t.Fatalf("stored key = %v, want %s", stored["serviceAccountKeySecretId"], key.ID)
+	}
+
+	if _, err := client.UpdateCloudProviderGcp(ctx, cp.ID, "gcp", "", []string{"gcp"}, false, nil); err != nil {
+		t.Fatalf("UpdateCloudProviderGcp: %v", err)
+	}
+
+	stored, _ := server.Entity("cloudProvider", cp.ID)
+	if stored["serviceAccountKeySecretId"] != "" {
+		t.Errorf("stored key after switching to delegate selectors = %v, want none", stored["serviceAccountKeySecretId"])
+	}
+	if stored["useDelegateSelectors"] != true {
+		t.Errorf("useDelegateSelectors = %v, want true", stored["useDelegateSelectors"])
+	}
+
+	got, err := client.GetCloudProviderGcp(ctx, cp.ID)
+	if err != nil {
+		t.Fatalf("GetCloudProviderGcp: %v", err)
+	}
+	if got.ServiceAccountKeySecretID != "" || len(got.DelegateSelectors) != 1 {
+		t.Errorf("GetCloudProviderGcp = key %q and selectors %v, want no key and [gcp]", got.ServiceAccountKeySecretID, got.DelegateSelectors)
+	}
+}
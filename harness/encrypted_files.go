@@ -0,0 +1,108 @@
+package harness
+
+import (
+	"context"
+	"encoding/base64"
+)
+
+// EncryptedFileSecret is a secret holding a file, such as a kubeconfig, a TLS
+// certificate or a Java keystore. Harness.io never returns the content of a
+// file once stored.
+type EncryptedFileSecret struct {
+	ID              string      `json:"id"`
+	Name            string      `json:"name"`
+	Content         []byte      `json:"content"`
+	SecretManagerID string      `json:"secretManagerId"`
+	ScopedToAccount bool        `json:"scopedToAccount"`
+	UsageScope      *UsageScope `json:"usageScope"`
+}
+
+func (h *Client) GetEncryptedFile(ctx context.Context, id string) (*EncryptedFileSecret, error) {
+	h.logger.Debugf("Getting a Harness.io encrypted file with id '%s'", id)
+
+	secret, err := h.secret(ctx, id, SecretTypeEncryptedFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, newNotFoundError("encrypted file")
+	}
+
+	return encryptedFile(secret), nil
+}
+
+func (h *Client) NewEncryptedFile(ctx context.Context, f *EncryptedFileSecret) (*EncryptedFileSecret, error) {
+	h.logger.Debugf("Creating a Harness.io encrypted file with name '%s'", f.Name)
+
+	payload, err := h.createSecret(ctx, &CreateSecretInput{
+		SecretType: SecretTypeEncryptedFile,
+		EncryptedFile: &EncryptedFileInput{
+			Name:            f.Name,
+			Content:         base64.StdEncoding.EncodeToString(f.Content),
+			SecretManagerID: f.SecretManagerID,
+			ScopedToAccount: Bool(f.ScopedToAccount),
+			UsageScope:      usageScopeInput(f.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("encrypted file")
+	}
+
+	return encryptedFile(payload.Secret), nil
+}
+
+// UpdateEncryptedFile updates an encrypted file, only replacing its content
+// when f has any.
+func (h *Client) UpdateEncryptedFile(ctx context.Context, f *EncryptedFileSecret) (*EncryptedFileSecret, error) {
+	h.logger.Debugf("Updating a Harness.io encrypted file with id '%s'", f.ID)
+
+	input := &UpdateEncryptedFile{
+		Name:            String(f.Name),
+		ScopedToAccount: Bool(f.ScopedToAccount),
+		UsageScope:      usageScopeInput(f.UsageScope),
+	}
+	if f.Content != nil {
+		input.Content = String(base64.StdEncoding.EncodeToString(f.Content))
+	}
+
+	payload, err := h.updateSecret(ctx, &UpdateSecretInput{
+		SecretID:      f.ID,
+		SecretType:    SecretTypeEncryptedFile,
+		EncryptedFile: input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("encrypted file")
+	}
+
+	return encryptedFile(payload.Secret), nil
+}
+
+func (h *Client) DeleteEncryptedFile(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io encrypted file with id '%s'", id)
+
+	_, err := h.deleteSecret(ctx, &DeleteSecretInput{
+		SecretID:   id,
+		SecretType: SecretTypeEncryptedFile,
+	})
+
+	return err
+}
+
+func encryptedFile(s *Secret) *EncryptedFileSecret {
+	return &EncryptedFileSecret{
+		ID:              s.ID,
+		Name:            s.Name,
+		SecretManagerID: s.SecretManagerID,
+		ScopedToAccount: s.ScopedToAccount,
+		UsageScope:      s.UsageScope,
+	}
+}
@@ -1,36 +1,9 @@
 package harness
 
 import (
-	"fmt"
-	"strings"
+	"context"
 )
 
-type ApplicationScope struct {
-	AppId      string `json:"appId,omitempty"`
-	FilterType string `json:"filterType,omitempty"`
-}
-
-type EnvironmentScope struct {
-	EnvId      string `json:"envId,omitempty"`
-	FilterType string `json:"filterType,omitempty"`
-}
-
-type AppEnvScope struct {
-	Application *ApplicationScope `json:"application"`
-	Environment *EnvironmentScope `json:"environment"`
-}
-
-type UsageScope struct {
-	AppEnvScopes []*AppEnvScope `json:"appEnvScopes"`
-}
-
-type NotFound struct {
-}
-
-func (e *NotFound) Error() string {
-	return fmt.Sprintf("Not found")
-}
-
 type EncryptedSecret struct {
 	ID              string      `json:"id"`
 	Name            string      `json:"name"`
@@ -40,175 +13,82 @@ type EncryptedSecret struct {
 	UsageScope      *UsageScope `json:"usageScope"`
 }
 
-type EncryptedSecretWrapper struct {
-	Secret *EncryptedSecret
-}
-
-type GetEncryptedSecretResponse struct {
-	Errors []Error
-	Data   *EncryptedSecretWrapper
-}
-
-type CreateEncryptedSecretWrapper struct {
-	CreateSecret *EncryptedSecretWrapper
-}
-
-type UpdateEncryptedSecretWrapper struct {
-	UpdateSecret *EncryptedSecretWrapper
-}
-
-type NewEncryptedSecretResponse struct {
-	Errors []Error
-	Data   *CreateEncryptedSecretWrapper
-}
-
-type DeleteEncryptedSecretApiResponse struct {
-	Errors []Error
-	Data   *CreateEncryptedSecretWrapper
-}
-
-type UpdateEncryptedSecretApiResponse struct {
-	Errors []Error
-	Data   *UpdateEncryptedSecretWrapper
-}
-
-func (h *Client) GetEncryptedSecret(id string) (*EncryptedSecret, error) {
-	query := `query { secret(secretId: "%s", secretType: ENCRYPTED_TEXT) { id name } }`
-	graphQLQuery := &GraphQLQuery{
-		Query: fmt.Sprintf(query, id),
-	}
-
-	response := &GetEncryptedSecretResponse{}
-	err := h.query(graphQLQuery, response)
+func (h *Client) GetEncryptedSecret(ctx context.Context, id string) (*EncryptedSecret, error) {
+	secret, err := h.secret(ctx, id, SecretTypeEncryptedText)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(response.Errors) > 0 {
-		if strings.Contains(response.Errors[0].Message, "No secret exists with given input") {
-			return nil, &NotFound{}
-		}
-
-		return nil, fmt.Errorf("Error retrieving secret: %#v", response.Errors)
+	if secret == nil {
+		return nil, newNotFoundError("secret")
 	}
 
-	return response.Data.Secret, nil
+	return encryptedSecret(secret), nil
 }
 
-func (h *Client) NewEncryptedSecret(s *EncryptedSecret) (*EncryptedSecret, error) {
-	query := `mutation($secret: CreateSecretInput!) {
-		createSecret(input: $secret){
-			secret {
-				id
-				name
-			}
-		}
-	}
-	`
-
-	graphQLQuery := &GraphQLQuery{
-		Query: query,
-		Variables: map[string]interface{}{
-			"secret": map[string]interface{}{
-				"secretType": "ENCRYPTED_TEXT",
-				"encryptedText": map[string]interface{}{
-					"scopedToAccount": s.ScopedToAccount,
-					"name":            s.Name,
-					"value":           s.Value,
-					"secretManagerId": s.SecretManagerID,
-					"usageScope":      s.UsageScope,
-				},
-			},
+func (h *Client) NewEncryptedSecret(ctx context.Context, s *EncryptedSecret) (*EncryptedSecret, error) {
+	payload, err := h.createSecret(ctx, &CreateSecretInput{
+		SecretType: SecretTypeEncryptedText,
+		EncryptedText: &EncryptedTextInput{
+			ScopedToAccount: Bool(s.ScopedToAccount),
+			Name:            s.Name,
+			Value:           String(s.Value),
+			SecretManagerID: s.SecretManagerID,
+			UsageScope:      usageScopeInput(s.UsageScope),
 		},
-	}
-
-	response := &NewEncryptedSecretResponse{}
-	err := h.query(graphQLQuery, response)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(response.Errors) > 0 {
-		return nil, fmt.Errorf("Error creating secret: %#v", response.Errors)
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("secret")
 	}
 
-	return response.Data.CreateSecret.Secret, nil
+	return encryptedSecret(payload.Secret), nil
 }
 
-func (h *Client) DeleteEncryptedSecret(id string) error {
-	fmt.Printf("Deleting a Harness.io secret with id '%s'", id)
-
-	query := `mutation($secret: DeleteSecretInput!){
-		deleteSecret(input: $secret) {
-			clientMutationId
-		}
-	}
-	`
-
-	graphQlQuery := &GraphQLQuery{
-		Query: query,
-		Variables: map[string]interface{}{
-			"secret": map[string]string{
-				"secretId":   id,
-				"secretType": "ENCRYPTED_TEXT",
-			},
-		},
-	}
-
-	apiResponse := &DeleteEncryptedSecretApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
+func (h *Client) DeleteEncryptedSecret(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io secret with id '%s'", id)
 
-	if err != nil {
-		return err
-	}
-
-	if len(apiResponse.Errors) > 0 {
-		return fmt.Errorf("Errors: %#v", apiResponse.Errors)
-	}
+	_, err := h.deleteSecret(ctx, &DeleteSecretInput{
+		SecretID:   id,
+		SecretType: SecretTypeEncryptedText,
+	})
 
-	return nil
+	return err
 }
 
-func (h *Client) UpdateEncryptedSecret(s *EncryptedSecret) (*EncryptedSecret, error) {
-	fmt.Printf("Updating Harness.io secret with id '%s'", s.ID)
-
-	query := `mutation($secret: UpdateSecretInput!){
-		updateSecret(input: $secret) {
-			clientMutationId
-			secret {
-				id
-				name
-			}
-		}
-	}
-	`
-
-	graphQlQuery := &GraphQLQuery{
-		Query: query,
-		Variables: map[string]interface{}{
-			"secret": map[string]interface{}{
-				"secretId":   s.ID,
-				"secretType": "ENCRYPTED_TEXT",
-				"encryptedText": map[string]interface{}{
-					"name":            s.Name,
-					"value":           s.Value,
-					"scopedToAccount": s.ScopedToAccount,
-					"usageScope":      s.UsageScope,
-				},
-			},
-		},
-	}
-
-	apiResponse := &UpdateEncryptedSecretApiResponse{}
-	err := h.query(graphQlQuery, &apiResponse)
+func (h *Client) UpdateEncryptedSecret(ctx context.Context, s *EncryptedSecret) (*EncryptedSecret, error) {
+	h.logger.Debugf("Updating Harness.io secret with id '%s'", s.ID)
 
+	payload, err := h.updateSecret(ctx, &UpdateSecretInput{
+		SecretID:   s.ID,
+		SecretType: SecretTypeEncryptedText,
+		EncryptedText: &UpdateEncryptedText{
+			Name:            String(s.Name),
+			Value:           String(s.Value),
+			ScopedToAccount: Bool(s.ScopedToAccount),
+			UsageScope:      usageScopeInput(s.UsageScope),
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(apiResponse.Errors) > 0 {
-		return nil, fmt.Errorf("Errors: %#v", apiResponse.Errors)
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("secret")
 	}
 
-	return apiResponse.Data.UpdateSecret.Secret, nil
+	return encryptedSecret(payload.Secret), nil
+}
+
+func encryptedSecret(s *Secret) *EncryptedSecret {
+	return &EncryptedSecret{
+		ID:              s.ID,
+		Name:            s.Name,
+		SecretManagerID: s.SecretManagerID,
+		ScopedToAccount: s.ScopedToAccount,
+		UsageScope:      s.UsageScope,
+	}
 }
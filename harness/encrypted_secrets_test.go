@@ -0,0 +1,117 @@
+package harness_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+)
+
+func TestEncryptedSecretCRUD(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	secret, err := client.NewEncryptedSecret(ctx, &harness.EncryptedSecret{
+		Name:            "password",
+		Value:           "hunter2",
+		SecretManagerID: "secret-manager",
+		ScopedToAccount: true,
+	})
+	if err != nil {
+		t.Fatalf("NewEncryptedSecret: %v", err)
+	}
+	if value, _ := server.SecretValue(secret.ID); value != "hunter2" {
+		t.Errorf("stored value = %q, want hunter2", value)
+	}
+
+	got, err := client.GetEncryptedSecret(ctx, secret.ID)
+	if err != nil {
+		t.Fatalf("GetEncryptedSecret: %v", err)
+	}
+	if got.Name != "password" || got.SecretManagerID != "secret-manager" || !got.ScopedToAccount {
+		t.Errorf("GetEncryptedSecret = %+v", got)
+	}
+	if got.Value != "" {
+		t.Errorf("GetEncryptedSecret returned the value %q, which Harness.io never does", got.Value)
+	}
+
+	secret.Value = "correct horse"
+	if _, err := client.UpdateEncryptedSecret(ctx, secret); err != nil {
+		t.Fatalf("UpdateEncryptedSecret: %v", err)
+	}
+	if value, _ := server.SecretValue(secret.ID); value != "correct horse" {
+		t.Errorf("stored value after update = %q, want correct horse", value)
+	}
+
+	if err := client.DeleteEncryptedSecret(ctx, secret.ID); err != nil {
+		t.Fatalf("DeleteEncryptedSecret: %v", err)
+	}
+	if _, ok := server.SecretValue(secret.ID); ok {
+		t.Error("value still stored after DeleteEncryptedSecret")
+	}
+	if _, err := client.GetEncryptedSecret(ctx, secret.ID); !errors.Is(err, harness.ErrNotFound) {
+		t.Errorf("GetEncryptedSecret of a deleted secret returned %v, want ErrNotFound", err)
+	}
+}
+
+// traceLogger keeps every trace line logged by a client.
+type traceLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *traceLogger) Debugf(format string, args ...interface{}) {}
+
+func (l *traceLogger) Tracef(format string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, args...))
+}
+
+func TestEncryptedSecretValueNotLogged(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	logger := &traceLogger{}
+	client := server.Client(harness.WithLogger(logger))
+	ctx := context.Background()
+
+	secret, err := client.NewEncryptedSecret(ctx, &harness.EncryptedSecret{
+		Name:            "password",
+		Value:           "hunter2",
+		SecretManagerID: "secret-manager",
+	})
+	if err != nil {
+		t.Fatalf("NewEncryptedSecret: %v", err)
+	}
+
+	secret.Value = "correct horse"
+	if _, err := client.UpdateEncryptedSecret(ctx, secret); err != nil {
+		t.Fatalf("UpdateEncryptedSecret: %v", err)
+	}
+
+	logged := map[string]bool{}
+	for _, line := range logger.lines {
+		for _, value := range []string{"hunter2", "correct horse"} {
+			if strings.Contains(line, value) {
+				t.Errorf("logged the secret value %q: %s", value, line)
+			}
+		}
+		for _, operation := range []string{"createSecret", "updateSecret"} {
+			if strings.Contains(line, operation) {
+				logged[operation] = true
+			}
+		}
+	}
+	for _, operation := range []string{"createSecret", "updateSecret"} {
+		if !logged[operation] {
+			t.Errorf("did not trace the %s request", operation)
+		}
+	}
+}
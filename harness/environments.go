@@ -0,0 +1,94 @@
+package harness
+
+import "context"
+
+// GetEnvironment fetches an environment of an application by its id.
+func (h *Client) GetEnvironment(ctx context.Context, appID string, id string) (*Environment, error) {
+	h.logger.Debugf("Getting a Harness.io environment with id '%s' in application '%s'", id, appID)
+
+	env, err := h.environment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if env == nil || env.ApplicationID != appID {
+		return nil, newNotFoundError("environment")
+	}
+
+	return env, nil
+}
+
+func (h *Client) NewEnvironment(ctx context.Context, e *Environment) (*Environment, error) {
+	h.logger.Debugf("Creating a Harness.io environment with name '%s' in application '%s'", e.Name, e.ApplicationID)
+
+	payload, err := h.createEnvironment(ctx, &CreateEnvironmentInput{
+		ApplicationID:     e.ApplicationID,
+		Name:              e.Name,
+		Description:       String(e.Description),
+		EnvironmentType:   e.Type,
+		Tags:              tagInputs(e.Tags),
+		VariableOverrides: variableOverrideInputs(e.VariableOverrides),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Environment == nil {
+		return nil, newNotFoundError("environment")
+	}
+
+	return payload.Environment, nil
+}
+
+func (h *Client) UpdateEnvironment(ctx context.Context, e *Environment) (*Environment, error) {
+	h.logger.Debugf("Updating a Harness.io environment with id '%s' in application '%s'", e.ID, e.ApplicationID)
+
+	payload, err := h.updateEnvironment(ctx, &UpdateEnvironmentInput{
+		ApplicationID:     e.ApplicationID,
+		EnvironmentID:     e.ID,
+		Name:              String(e.Name),
+		Description:       String(e.Description),
+		EnvironmentType:   e.Type,
+		Tags:              tagInputs(e.Tags),
+		VariableOverrides: variableOverrideInputs(e.VariableOverrides),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Environment == nil {
+		return nil, newNotFoundError("environment")
+	}
+
+	return payload.Environment, nil
+}
+
+func (h *Client) DeleteEnvironment(ctx context.Context, appID string, id string) error {
+	h.logger.Debugf("Deleting a Harness.io environment with id '%s' in application '%s'", id, appID)
+
+	_, err := h.deleteEnvironment(ctx, &DeleteEnvironmentInput{
+		ApplicationID: appID,
+		EnvironmentID: id,
+	})
+
+	return err
+}
+
+// variableOverrideInputs converts the variable overrides of an environment
+// into the input used to write them, always returning a list so that
+// overrides that were removed are cleared.
+func variableOverrideInputs(overrides []*VariableOverride) []*VariableOverrideInput {
+	inputs := make([]*VariableOverrideInput, 0, len(overrides))
+	for _, o := range overrides {
+		input := &VariableOverrideInput{
+			Name:  o.Name,
+			Type:  o.Type,
+			Value: o.Value,
+		}
+		if o.ServiceID != "" {
+			input.ServiceID = String(o.ServiceID)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
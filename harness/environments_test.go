@@ -0,0 +1,62 @@
+package harness_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+)
+
+func TestEnvironmentCRUD(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	app, err := client.NewApplication(ctx, &harness.Application{Name: "app"})
+	if err != nil {
+		t.Fatalf("NewApplication: %v", err)
+	}
+	other, err := client.NewApplication(ctx, &harness.Application{Name: "other"})
+	if err != nil {
+		t.Fatalf("NewApplication: %v", err)
+	}
+
+	env, err := client.NewEnvironment(ctx, &harness.Environment{
+		ApplicationID: app.ID,
+		Name:          "dev",
+		Type:          harness.EnvironmentTypeNonProd,
+	})
+	if err != nil {
+		t.Fatalf("NewEnvironment: %v", err)
+	}
+
+	if got, err := client.GetEnvironment(ctx, app.ID, env.ID); err != nil || got.Name != "dev" {
+		t.Errorf("GetEnvironment = %+v, %v", got, err)
+	}
+	if _, err := client.GetEnvironment(ctx, other.ID, env.ID); !errors.Is(err, harness.ErrNotFound) {
+		t.Errorf("GetEnvironment in another application returned %v, want ErrNotFound", err)
+	}
+
+	env.Type = harness.EnvironmentTypeProd
+	if _, err := client.UpdateEnvironment(ctx, env); err != nil {
+		t.Fatalf("UpdateEnvironment: %v", err)
+	}
+	if stored, _ := server.Entity("environment", env.ID); stored["type"] != "PROD" {
+		t.Errorf("stored environment after update = %v", stored)
+	}
+
+	if _, err := client.NewEnvironment(ctx, &harness.Environment{ApplicationID: app.ID, Name: "dev", Type: harness.EnvironmentTypeProd}); !errors.Is(err, harness.ErrConflict) {
+		t.Errorf("NewEnvironment with a duplicate name returned %v, want ErrConflict", err)
+	}
+
+	// Deleting the application deletes its environments
+	if err := client.DeleteApplication(ctx, app.ID); err != nil {
+		t.Fatalf("DeleteApplication: %v", err)
+	}
+	if _, err := client.GetEnvironment(ctx, app.ID, env.ID); !errors.Is(err, harness.ErrNotFound) {
+		t.Errorf("GetEnvironment of a deleted application returned %v, want ErrNotFound", err)
+	}
+}
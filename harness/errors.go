@@ -0,0 +1,217 @@
+package harness
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Error kinds returned by the client. Use errors.Is to test for them, and
+// errors.As with *APIError to get at the details Harness.io reported.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrConflict     = errors.New("conflict")
+	ErrValidation   = errors.New("validation failed")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServerError  = errors.New("server error")
+)
+
+// Error is a single entry of the errors array of a GraphQL response.
+type Error struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+// APIError is returned when Harness.io rejects a request, either through the
+// HTTP status or through the errors of a GraphQL response.
+type APIError struct {
+	// Kind is one of the Err* values of this package.
+	Kind error
+	// StatusCode is the HTTP status Harness.io responded with.
+	StatusCode int
+	// Operation is the GraphQL operation name, when the request had one.
+	Operation string
+	Message   string
+	// Path is the GraphQL response path the first error relates to.
+	Path []interface{}
+	// Field is the input field Harness.io reported as invalid, if any.
+	Field string
+	// Errors holds every GraphQL error of the response.
+	Errors []Error
+	// Credentials describes the credentials of a request rejected as
+	// unauthorized or forbidden, such as "API key".
+	Credentials string
+
+	retryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = e.Kind.Error()
+	}
+
+	if e.Operation != "" {
+		return fmt.Sprintf("Harness.io %s: %s", e.Operation, message)
+	}
+	return fmt.Sprintf("Harness.io: %s", message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Kind
+}
+
+// errorCodes maps the codes Harness.io puts in GraphQL error extensions to an
+// error kind.
+var errorCodes = map[string]error{
+	"NOT_FOUND":           ErrNotFound,
+	"ENTITY_NOT_FOUND":    ErrNotFound,
+	"UNAUTHORIZED":        ErrUnauthorized,
+	"USER_NOT_AUTHORIZED": ErrUnauthorized,
+	"INVALID_TOKEN":       ErrUnauthorized,
+	"FORBIDDEN":           ErrForbidden,
+	"ACCESS_DENIED":       ErrForbidden,
+	"CONFLICT":            ErrConflict,
+	"DUPLICATE_ENTITY":    ErrConflict,
+	"INVALID_REQUEST":     ErrValidation,
+	"INVALID_ARGUMENT":    ErrValidation,
+	"VALIDATION_ERROR":    ErrValidation,
+	"RATE_LIMITED":        ErrRateLimited,
+	"INTERNAL_ERROR":      ErrServerError,
+	"RESOURCE_NOT_FOUND":  ErrNotFound,
+	"INVALID_YAML":        ErrValidation,
+}
+
+// errorMessages maps fragments of messages Harness.io returns without an
+// error code to an error kind. The first match wins.
+var errorMessages = []struct {
+	fragment string
+	kind     error
+}{
+	{"user not authorized", ErrUnauthorized},
+	{"not authorized", ErrUnauthorized},
+	{"invalid api key", ErrUnauthorized},
+	{"access denied", ErrForbidden},
+	{"does not exist", ErrNotFound},
+	{"no secret exists", ErrNotFound},
+	{"not found", ErrNotFound},
+	{"already exists", ErrConflict},
+	{"duplicate", ErrConflict},
+	{"too many requests", ErrRateLimited},
+	{"invalid", ErrValidation},
+	{"cannot be empty", ErrValidation},
+	{"must not be", ErrValidation},
+	{"validation", ErrValidation},
+}
+
+// retryable reports whether the request was rejected by a gateway in a way
+// that is expected to clear up by itself.
+func (e *APIError) retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func newStatusError(statusCode int, operation string) *APIError {
+	return &APIError{
+		Kind:       kindFromStatus(statusCode),
+		StatusCode: statusCode,
+		Operation:  operation,
+		Message:    fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+	}
+}
+
+// newNotFoundError is returned when Harness.io responded without errors but
+// also without the entity that was asked for.
+func newNotFoundError(entity string) *APIError {
+	return &APIError{
+		Kind:       ErrNotFound,
+		StatusCode: http.StatusOK,
+		Message:    fmt.Sprintf("%s does not exist", entity),
+	}
+}
+
+func newGraphQLError(statusCode int, operation string, errs []Error) *APIError {
+	first := errs[0]
+
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		messages = append(messages, e.Message)
+	}
+
+	apiError := &APIError{
+		Kind:       kindFromGraphQL(first),
+		StatusCode: statusCode,
+		Operation:  operation,
+		Message:    strings.Join(messages, "; "),
+		Path:       first.Path,
+		Errors:     errs,
+	}
+
+	if field, ok := first.Extensions["field"].(string); ok {
+		apiError.Field = field
+	}
+
+	if apiError.Kind == nil {
+		apiError.Kind = kindFromStatus(statusCode)
+	}
+
+	return apiError
+}
+
+// newRESTError is returned when the REST API of Harness.io answered with
+// error messages, which are classified like GraphQL errors.
+func newRESTError(statusCode int, operation string, messages []responseMessage) *APIError {
+	errs := make([]Error, 0, len(messages))
+	for _, m := range messages {
+		errs = append(errs, Error{
+			Message:    m.Message,
+			Extensions: map[string]interface{}{"code": m.Code},
+		})
+	}
+
+	return newGraphQLError(statusCode, operation, errs)
+}
+
+func kindFromGraphQL(e Error) error {
+	if code, ok := e.Extensions["code"].(string); ok {
+		if kind, ok := errorCodes[strings.ToUpper(code)]; ok {
+			return kind
+		}
+	}
+
+	message := strings.ToLower(e.Message)
+	for _, m := range errorMessages {
+		if strings.Contains(message, m.fragment) {
+			return m.kind
+		}
+	}
+
+	return nil
+}
+
+func kindFromStatus(statusCode int) error {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case statusCode == http.StatusForbidden:
+		return ErrForbidden
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusConflict:
+		return ErrConflict
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode >= 500:
+		return ErrServerError
+	default:
+		return ErrValidation
+	}
+}
@@ -0,0 +1,6 @@
+package harness
+
+// The types and operations in schema_gen.go are generated from the checked-in
+// introspection dump of the Harness.io schema. To support another operation,
+// add it to schema/generate.json and run go generate.
+//go:generate go run ./internal/schemagen -schema schema/schema.json -config schema/generate.json -out schema_gen.go
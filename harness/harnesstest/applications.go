@@ -0,0 +1,83 @@
+package harnesstest
+
+func (s *Server) registerApplications() {
+	s.queries["application"] = s.application
+	s.queries["applicationByName"] = s.applicationByName
+	s.queries["applications"] = s.applications
+	s.mutations["createApplication"] = s.createApplication
+	s.mutations["updateApplication"] = s.updateApplication
+	s.mutations["deleteApplication"] = s.deleteApplication
+}
+
+// applicationNotAuthorized is what Harness.io answers when an application id
+// does not exist, rather than a not found error.
+func applicationNotAuthorized() error {
+	return &graphQLError{Message: "User not authorized"}
+}
+
+func (s *Server) application(args map[string]interface{}) (interface{}, error) {
+	app, ok := s.get("application", stringArg(args, "applicationId"))
+	if !ok {
+		return nil, applicationNotAuthorized()
+	}
+	return app, nil
+}
+
+func (s *Server) applicationByName(args map[string]interface{}) (interface{}, error) {
+	app, ok := s.findByName("application", nil, stringArg(args, "name"))
+	if !ok {
+		return nil, notFound("Application does not exist")
+	}
+	return app, nil
+}
+
+func (s *Server) applications(args map[string]interface{}) (interface{}, error) {
+	return s.connection("application", args, filterFields{
+		"application": "id",
+	})
+}
+
+func (s *Server) createApplication(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	if err := s.checkName("application", "Application", "", input); err != nil {
+		return nil, err
+	}
+
+	app := map[string]interface{}{
+		"id":          s.newID(),
+		"description": nil,
+	}
+	merge(app, input, "clientMutationId")
+	s.put("application", app)
+
+	return payload(input, "application", app), nil
+}
+
+func (s *Server) updateApplication(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "applicationId")
+
+	app, ok := s.get("application", id)
+	if !ok {
+		return nil, applicationNotAuthorized()
+	}
+	if err := s.checkName("application", "Application", id, input); err != nil {
+		return nil, err
+	}
+
+	merge(app, input, "clientMutationId", "applicationId")
+
+	return payload(input, "application", app), nil
+}
+
+func (s *Server) deleteApplication(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "applicationId")
+	if !s.remove("application", id) {
+		return nil, applicationNotAuthorized()
+	}
+	s.removeChildren("applicationId", id)
+	s.removeAppFromUserGroups(id)
+
+	return payload(input, "", nil), nil
+}
@@ -0,0 +1,241 @@
+package harnesstest
+
+import "fmt"
+
+// cloudProviderTypes maps the cloudProviderType enum to the input field
+// holding the details of the cloud provider, the GraphQL type it is returned
+// as and, when they need it, how its details are checked.
+var cloudProviderTypes = map[string]struct {
+	input    string
+	typeName string
+	check    func(s *Server, cp map[string]interface{}, details map[string]interface{}) error
+}{
+	"AWS":                {"awsCloudProvider", "AwsCloudProvider", (*Server).checkAWSCloudProvider},
+	"AZURE":              {"azureCloudProvider", "AzureCloudProvider", nil},
+	"GCP":                {"gcpCloudProvider", "GcpCloudProvider", (*Server).checkGCPCloudProvider},
+	"KUBERNETES_CLUSTER": {"k8sCloudProvider", "KubernetesCloudProvider", nil},
+}
+
+func (s *Server) registerCloudProviders() {
+	s.queries["cloudProvider"] = s.cloudProvider
+	s.queries["cloudProviders"] = s.cloudProviders
+	s.mutations["createCloudProvider"] = s.createCloudProvider
+	s.mutations["updateCloudProvider"] = s.updateCloudProvider
+	s.mutations["deleteCloudProvider"] = s.deleteCloudProvider
+}
+
+func cloudProviderNotFound() error {
+	return notFound("Cloud Provider does not exist")
+}
+
+func (s *Server) cloudProvider(args map[string]interface{}) (interface{}, error) {
+	cp, ok := s.get("cloudProvider", stringArg(args, "cloudProviderId"))
+	if !ok {
+		return nil, cloudProviderNotFound()
+	}
+	return cp, nil
+}
+
+func (s *Server) cloudProviders(args map[string]interface{}) (interface{}, error) {
+	return s.connection("cloudProvider", args, filterFields{
+		"cloudProvider":     "id",
+		"cloudProviderType": "cloudProviderType",
+	})
+}
+
+// cloudProviderDetails returns the details of the cloud provider input holds,
+// checked against cp, which is nil on creation.
+func (s *Server) cloudProviderDetails(cp map[string]interface{}, input map[string]interface{}) (string, map[string]interface{}, error) {
+	cloudProviderType := stringArg(input, "cloudProviderType")
+	kind, ok := cloudProviderTypes[cloudProviderType]
+	if !ok {
+		return "", nil, invalid("cloudProviderType", fmt.Sprintf("Invalid request: unsupported cloud provider type %s", cloudProviderType))
+	}
+
+	details, _ := input[kind.input].(map[string]interface{})
+	if details == nil {
+		return "", nil, invalid(kind.input, fmt.Sprintf("Invalid request: %s must be provided for cloud provider type %s", kind.input, cloudProviderType))
+	}
+
+	if kind.check != nil {
+		if err := kind.check(s, cp, details); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return kind.typeName, details, nil
+}
+
+func (s *Server) createCloudProvider(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	typeName, details, err := s.cloudProviderDetails(nil, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkName("cloudProvider", "Cloud Provider", "", details); err != nil {
+		return nil, err
+	}
+
+	cp := map[string]interface{}{
+		"id":                s.newID(),
+		"description":       nil,
+		"type":              input["cloudProviderType"],
+		"cloudProviderType": input["cloudProviderType"],
+		"__typename":        typeName,
+	}
+	merge(cp, details)
+	s.put("cloudProvider", cp)
+
+	return payload(input, "cloudProvider", cp), nil
+}
+
+func (s *Server) updateCloudProvider(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "cloudProviderId")
+
+	cp, ok := s.get("cloudProvider", id)
+	if !ok {
+		return nil, cloudProviderNotFound()
+	}
+
+	if input["cloudProviderType"] != cp["cloudProviderType"] {
+		return nil, invalid("cloudProviderType", "Invalid request: the type of a cloud provider cannot be changed")
+	}
+	_, details, err := s.cloudProviderDetails(cp, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkName("cloudProvider", "Cloud Provider", id, details); err != nil {
+		return nil, err
+	}
+
+	merge(cp, details)
+
+	return payload(input, "cloudProvider", cp), nil
+}
+
+func (s *Server) deleteCloudProvider(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	if !s.remove("cloudProvider", stringArg(input, "cloudProviderId")) {
+		return nil, cloudProviderNotFound()
+	}
+
+	return payload(input, "", nil), nil
+}
+
+// checkAWSCloudProvider validates the credentials of an AWS cloud provider
+// and flattens them into the fields it is returned with. The credentials,
+// including the cross account role, are replaced whenever credentialsType is
+// given. cp is nil on creation.
+func (s *Server) checkAWSCloudProvider(cp map[string]interface{}, details map[string]interface{}) error {
+	credentialsType := stringArg(details, "credentialsType")
+	if credentialsType == "" {
+		if cp == nil {
+			return invalid("credentialsType", "Invalid request: credentialsType cannot be empty")
+		}
+		return nil
+	}
+
+	manual, _ := details["manualCredentials"].(map[string]interface{})
+	ec2IAM, _ := details["ec2IamCredentials"].(map[string]interface{})
+	irsa, _ := details["irsaCredentials"].(map[string]interface{})
+	crossAccount, _ := details["crossAccountAttributes"].(map[string]interface{})
+
+	flat := map[string]interface{}{
+		"accessKey":              "",
+		"accessKeySecretId":      "",
+		"secretKeySecretId":      "",
+		"delegateSelectors":      []interface{}{},
+		"assumeCrossAccountRole": false,
+		"crossAccountRoleArn":    "",
+		"externalId":             "",
+	}
+
+	switch credentialsType {
+	case "MANUAL":
+		if manual == nil {
+			return invalid("manualCredentials", "Invalid request: manualCredentials must be provided for credentials of type MANUAL")
+		}
+		accessKey := stringArg(manual, "accessKey")
+		accessKeySecretID := stringArg(manual, "accessKeySecretId")
+		if (accessKey == "") == (accessKeySecretID == "") {
+			return invalid("accessKey", "Invalid request: either accessKey or accessKeySecretId must be provided")
+		}
+		if accessKeySecretID != "" {
+			if err := s.checkSecretRef("accessKeySecretId", accessKeySecretID, "ENCRYPTED_TEXT"); err != nil {
+				return err
+			}
+		}
+		if err := s.checkSecretRef("secretKeySecretId", stringArg(manual, "secretKeySecretId"), "ENCRYPTED_TEXT"); err != nil {
+			return err
+		}
+		flat["accessKey"] = accessKey
+		flat["accessKeySecretId"] = accessKeySecretID
+		flat["secretKeySecretId"] = manual["secretKeySecretId"]
+	case "EC2_IAM", "IRSA":
+		delegate, field := ec2IAM, "ec2IamCredentials"
+		if credentialsType == "IRSA" {
+			delegate, field = irsa, "irsaCredentials"
+		}
+		selectors, _ := delegate["delegateSelectors"].([]interface{})
+		if len(selectors) == 0 {
+			return invalid(field, fmt.Sprintf("Invalid request: %s must hold delegateSelectors for credentials of type %s", field, credentialsType))
+		}
+		flat["delegateSelectors"] = selectors
+	default:
+		return invalid("credentialsType", fmt.Sprintf("Invalid request: unsupported credentials type %s", credentialsType))
+	}
+
+	if crossAccount != nil && crossAccount["assumeCrossAccountRole"] != false {
+		if !awsRole.MatchString(stringArg(crossAccount, "crossAccountRoleArn")) {
+			return invalid("crossAccountRoleArn", "Invalid request: crossAccountRoleArn must be the ARN of an IAM role")
+		}
+		flat["assumeCrossAccountRole"] = true
+		flat["crossAccountRoleArn"] = crossAccount["crossAccountRoleArn"]
+		flat["externalId"] = stringArg(crossAccount, "externalId")
+	}
+
+	for _, field := range []string{"manualCredentials", "ec2IamCredentials", "irsaCredentials", "crossAccountAttributes"} {
+		delete(details, field)
+	}
+	merge(details, flat)
+
+	return nil
+}
+
+// checkGCPCloudProvider validates how a GCP cloud provider authenticates,
+// clearing the delegate selectors of one switched to a key. The key itself is
+// left for the client to clear. The key of the service account is only read
+// when validation is not skipped. cp is nil on creation.
+func (s *Server) checkGCPCloudProvider(cp map[string]interface{}, details map[string]interface{}) error {
+	useDelegateSelectors, ok := details["useDelegateSelectors"].(bool)
+	if !ok {
+		if cp != nil {
+			return nil
+		}
+		details["useDelegateSelectors"] = false
+	}
+
+	if useDelegateSelectors {
+		if selectors, _ := details["delegateSelectors"].([]interface{}); len(selectors) == 0 {
+			return invalid("delegateSelectors", "Invalid request: delegateSelectors cannot be empty when using delegate selectors")
+		}
+		return nil
+	}
+
+	secretID := stringArg(details, "serviceAccountKeySecretId")
+	if secretID == "" {
+		return invalid("serviceAccountKeySecretId", "Invalid request: serviceAccountKeySecretId cannot be empty when not using delegate selectors")
+	}
+	if err := s.checkSecretRef("serviceAccountKeySecretId", secretID, "ENCRYPTED_FILE"); err != nil {
+		return err
+	}
+	if skipValidation, _ := details["skipValidation"].(bool); !skipValidation {
+		if _, err := s.serviceAccountProject("serviceAccountKeySecretId", secretID); err != nil {
+			return err
+		}
+	}
+	details["delegateSelectors"] = []interface{}{}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package harnesstest
+
+import "fmt"
+
+// maxPageSize is the largest limit Harness.io accepts for a connection.
+const maxPageSize = 100
+
+// filterFields maps the filters a connection accepts to the entity field
+// each of them matches.
+type filterFields map[string]string
+
+// connection answers a limit/offset query over the entities of a kind,
+// keeping those matching every filter.
+func (s *Server) connection(kind string, args map[string]interface{}, fields filterFields) (interface{}, error) {
+	limit, ok := intArg(args, "limit")
+	if !ok || limit <= 0 || limit > maxPageSize {
+		return nil, invalid("limit", fmt.Sprintf("Invalid request: limit must be between 1 and %d", maxPageSize))
+	}
+	offset, _ := intArg(args, "offset")
+	if offset < 0 {
+		return nil, invalid("offset", "Invalid request: offset cannot be negative")
+	}
+
+	filters, _ := args["filters"].([]interface{})
+	for _, f := range filters {
+		filter, _ := f.(map[string]interface{})
+		for name := range filter {
+			if _, ok := fields[name]; !ok {
+				return nil, invalid("filters", fmt.Sprintf("Invalid request: unknown filter %s", name))
+			}
+		}
+	}
+
+	var matched []interface{}
+	for _, entity := range s.list(kind) {
+		if matchesFilters(entity, filters, fields) {
+			matched = append(matched, entity)
+		}
+	}
+
+	nodes := []interface{}{}
+	if offset < len(matched) {
+		end := offset + limit
+		if end > len(matched) {
+			end = len(matched)
+		}
+		nodes = matched[offset:end]
+	}
+
+	return map[string]interface{}{
+		"pageInfo": map[string]interface{}{
+			"limit":   limit,
+			"offset":  offset,
+			"hasMore": offset+len(nodes) < len(matched),
+			"total":   len(matched),
+		},
+		"nodes": nodes,
+	}, nil
+}
+
+func matchesFilters(entity map[string]interface{}, filters []interface{}, fields filterFields) bool {
+	for _, f := range filters {
+		filter, _ := f.(map[string]interface{})
+		for name, condition := range filter {
+			condition, _ := condition.(map[string]interface{})
+			if condition != nil && !matches(entity[fields[name]], condition) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matches applies an id or enum filter, such as
+// {operator: IN, values: [AZURE, GCP]}, to a field value. A list field, such
+// as the user groups of a user, matches when any of its items does.
+func matches(value interface{}, condition map[string]interface{}) bool {
+	items, isList := value.([]interface{})
+	if !isList {
+		items = []interface{}{value}
+	}
+
+	values, _ := condition["values"].([]interface{})
+	in := false
+	for _, v := range values {
+		for _, item := range items {
+			if v == item {
+				in = true
+			}
+		}
+	}
+
+	switch condition["operator"] {
+	case "NOT_IN":
+		return !in
+	case "NOT_NULL":
+		return value != nil
+	default:
+		return in
+	}
+}
+
+func intArg(m map[string]interface{}, key string) (int, bool) {
+	switch v := m[key].(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	}
+	return 0, false
+}
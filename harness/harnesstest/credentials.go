@@ -0,0 +1,157 @@
+package harnesstest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// checkSecretRef checks that the secret a credential refers to exists and is
+// of one of the given types.
+func (s *Server) checkSecretRef(field string, id string, secretTypes ...string) error {
+	for _, secretType := range secretTypes {
+		if _, err := s.lookupSecret(id, secretType); err == nil {
+			return nil
+		}
+	}
+	return invalid(field, fmt.Sprintf("Invalid request: secret %s does not exist", id))
+}
+
+// serviceAccountProject returns the project of the GCP service account whose
+// JSON key the encrypted file of id holds.
+func (s *Server) serviceAccountProject(field string, id string) (string, error) {
+	var key struct {
+		Type      string `json:"type"`
+		ProjectID string `json:"project_id"`
+	}
+	if err := json.Unmarshal([]byte(s.secrets[id]), &key); err != nil || key.Type != "service_account" || key.ProjectID == "" {
+		return "", invalid(field, fmt.Sprintf("Invalid request: secret %s does not hold the JSON key of a service account", id))
+	}
+
+	return key.ProjectID, nil
+}
+
+// checkSSHCredential validates how an SSH credential authenticates and sets
+// the authenticationType returned for it. secret is nil on creation.
+func (s *Server) checkSSHCredential(secret map[string]interface{}, details map[string]interface{}) error {
+	scheme := stringArg(details, "authenticationScheme")
+	if scheme == "" && secret != nil {
+		scheme = stringArg(secret, "authenticationScheme")
+	}
+
+	switch scheme {
+	case "SSH":
+		auth, _ := details["sshAuthentication"].(map[string]interface{})
+		if auth == nil {
+			if secret != nil && details["authenticationScheme"] == nil {
+				return nil
+			}
+			return invalid("sshAuthentication", "Invalid request: sshAuthentication must be provided for authentication scheme SSH")
+		}
+		if err := s.checkSSHAuthenticationMethod(auth); err != nil {
+			return err
+		}
+
+		details["authenticationType"] = map[string]interface{}{
+			"__typename": "SSHAuthentication",
+			"port":       auth["port"],
+			"userName":   auth["userName"],
+		}
+	case "KERBEROS":
+		auth, _ := details["kerberosAuthentication"].(map[string]interface{})
+		if auth == nil {
+			if secret != nil && details["authenticationScheme"] == nil {
+				return nil
+			}
+			return invalid("kerberosAuthentication", "Invalid request: kerberosAuthentication must be provided for authentication scheme KERBEROS")
+		}
+		if err := s.checkTGTGenerationMethod(auth); err != nil {
+			return err
+		}
+
+		details["authenticationType"] = map[string]interface{}{
+			"__typename": "KerberosAuthentication",
+			"port":       auth["port"],
+			"principal":  auth["principal"],
+			"realm":      auth["realm"],
+		}
+	default:
+		return invalid("authenticationScheme", fmt.Sprintf("Invalid request: unsupported authentication scheme %s", scheme))
+	}
+
+	details["authenticationScheme"] = scheme
+	return nil
+}
+
+func (s *Server) checkSSHAuthenticationMethod(auth map[string]interface{}) error {
+	method, _ := auth["sshAuthenticationMethod"].(map[string]interface{})
+	if method == nil {
+		return invalid("sshAuthenticationMethod", "Invalid request: sshAuthenticationMethod cannot be empty")
+	}
+
+	switch credentialType := stringArg(method, "sshCredentialType"); credentialType {
+	case "SSH_KEY":
+		key, _ := method["inlineSSHKey"].(map[string]interface{})
+		if key == nil {
+			return invalid("inlineSSHKey", "Invalid request: inlineSSHKey must be provided for credential type SSH_KEY")
+		}
+		if err := s.checkSecretRef("sshKeySecretFileId", stringArg(key, "sshKeySecretFileId"), "ENCRYPTED_TEXT", "ENCRYPTED_FILE"); err != nil {
+			return err
+		}
+		if passphrase := stringArg(key, "passphraseSecretId"); passphrase != "" {
+			return s.checkSecretRef("passphraseSecretId", passphrase, "ENCRYPTED_TEXT")
+		}
+	case "PASSWORD":
+		password, _ := method["serverPassword"].(map[string]interface{})
+		if password == nil {
+			return invalid("serverPassword", "Invalid request: serverPassword must be provided for credential type PASSWORD")
+		}
+		return s.checkSecretRef("passwordSecretId", stringArg(password, "passwordSecretId"), "ENCRYPTED_TEXT")
+	default:
+		return invalid("sshCredentialType", fmt.Sprintf("Invalid request: unsupported SSH credential type %s", credentialType))
+	}
+
+	return nil
+}
+
+func (s *Server) checkTGTGenerationMethod(auth map[string]interface{}) error {
+	method, _ := auth["tgtGenerationMethod"].(map[string]interface{})
+	if method == nil {
+		return nil
+	}
+
+	switch using := stringArg(method, "tgtGenerationUsing"); using {
+	case "KEY_TAB_FILE":
+		keyTab, _ := method["keyTabFile"].(map[string]interface{})
+		if stringArg(keyTab, "filePath") == "" {
+			return invalid("keyTabFile", "Invalid request: keyTabFile cannot be empty when generating tickets using KEY_TAB_FILE")
+		}
+	case "PASSWORD":
+		password, _ := method["kerberosPassword"].(map[string]interface{})
+		if password == nil {
+			return invalid("kerberosPassword", "Invalid request: kerberosPassword cannot be empty when generating tickets using PASSWORD")
+		}
+		return s.checkSecretRef("passwordSecretId", stringArg(password, "passwordSecretId"), "ENCRYPTED_TEXT")
+	default:
+		return invalid("tgtGenerationUsing", fmt.Sprintf("Invalid request: unsupported TGT generation %s", using))
+	}
+
+	return nil
+}
+
+// checkWinRMCredential validates the user and the password of a WinRM
+// credential. secret is nil on creation.
+func (s *Server) checkWinRMCredential(secret map[string]interface{}, details map[string]interface{}) error {
+	if secret == nil && stringArg(details, "userName") == "" {
+		return invalid("userName", "Invalid request: userName cannot be empty")
+	}
+
+	password := stringArg(details, "passwordSecretId")
+	if password == "" {
+		if secret == nil {
+			return invalid("passwordSecretId", "Invalid request: passwordSecretId cannot be empty")
+		}
+		return nil
+	}
+
+	return s.checkSecretRef("passwordSecretId", password, "ENCRYPTED_TEXT")
+}
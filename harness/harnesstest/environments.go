@@ -0,0 +1,107 @@
+package harnesstest
+
+func (s *Server) registerEnvironments() {
+	s.queries["environment"] = s.environment
+	s.mutations["createEnvironment"] = s.createEnvironment
+	s.mutations["updateEnvironment"] = s.updateEnvironment
+	s.mutations["deleteEnvironment"] = s.deleteEnvironment
+}
+
+func environmentNotFound() error {
+	return notFound("Environment does not exist")
+}
+
+func (s *Server) environment(args map[string]interface{}) (interface{}, error) {
+	env, ok := s.get("environment", stringArg(args, "environmentId"))
+	if !ok {
+		return nil, environmentNotFound()
+	}
+	return env, nil
+}
+
+// checkVariableOverrides makes sure the services overrides refer to belong
+// to the application of the environment.
+func (s *Server) checkVariableOverrides(appID string, input map[string]interface{}) error {
+	overrides, _ := input["variableOverrides"].([]interface{})
+	for _, o := range overrides {
+		override, _ := o.(map[string]interface{})
+		serviceID := stringArg(override, "serviceId")
+		if serviceID == "" {
+			continue
+		}
+		if svc, ok := s.get("service", serviceID); !ok || svc["applicationId"] != appID {
+			return invalid("variableOverrides", "Invalid request: service "+serviceID+" does not exist in the application")
+		}
+	}
+	return nil
+}
+
+func (s *Server) createEnvironment(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	appID, err := s.lookupApplication(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameIn("environment", "Environment", scope{"applicationId": appID}, "", input); err != nil {
+		return nil, err
+	}
+	if stringArg(input, "environmentType") == "" {
+		return nil, invalid("environmentType", "Invalid request: environmentType cannot be empty")
+	}
+	if err := s.checkVariableOverrides(appID, input); err != nil {
+		return nil, err
+	}
+
+	env := map[string]interface{}{
+		"id":                s.newID(),
+		"description":       nil,
+		"tags":              []interface{}{},
+		"variableOverrides": []interface{}{},
+	}
+	s.storeEnvironment(env, input)
+
+	return payload(input, "environment", env), nil
+}
+
+func (s *Server) updateEnvironment(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	env, err := s.lookupAppEntity("environment", input, "environmentId", environmentNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	appID := env["applicationId"].(string)
+	if err := s.checkNameIn("environment", "Environment", scope{"applicationId": appID}, env["id"].(string), input); err != nil {
+		return nil, err
+	}
+	if err := s.checkVariableOverrides(appID, input); err != nil {
+		return nil, err
+	}
+
+	s.storeEnvironment(env, input)
+
+	return payload(input, "environment", env), nil
+}
+
+func (s *Server) deleteEnvironment(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	env, err := s.lookupAppEntity("environment", input, "environmentId", environmentNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove("environment", env["id"].(string))
+	s.removeChildren("environmentId", env["id"].(string))
+
+	return payload(input, "", nil), nil
+}
+
+// storeEnvironment saves an environment, which Harness.io returns with its
+// environmentType as type.
+func (s *Server) storeEnvironment(env map[string]interface{}, input map[string]interface{}) {
+	merge(env, input, "clientMutationId", "environmentId", "environmentType")
+	if environmentType, ok := input["environmentType"].(string); ok {
+		env["type"] = environmentType
+	}
+	s.put("environment", env)
+}
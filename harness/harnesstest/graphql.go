@@ -0,0 +1,451 @@
+package harnesstest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// This file holds just enough of a GraphQL parser to execute the documents
+// the harness client sends: operations with variables, aliased fields with
+// arguments, nested selections and inline fragments. Named fragments and
+// directives are not supported.
+
+type operation struct {
+	kind      string
+	name      string
+	defaults  map[string]interface{}
+	selection []*field
+}
+
+type field struct {
+	alias     string
+	name      string
+	arguments map[string]value
+	selection []*field
+	// typeCondition is set on fields coming from an inline fragment.
+	typeCondition string
+}
+
+func (f *field) responseKey() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+// value is an argument value that may reference variables.
+type value interface {
+	resolve(variables map[string]interface{}) interface{}
+}
+
+type literal struct{ v interface{} }
+
+func (l literal) resolve(map[string]interface{}) interface{} { return l.v }
+
+type variable struct{ name string }
+
+func (v variable) resolve(variables map[string]interface{}) interface{} { return variables[v.name] }
+
+type listValue []value
+
+func (l listValue) resolve(variables map[string]interface{}) interface{} {
+	out := make([]interface{}, 0, len(l))
+	for _, v := range l {
+		out = append(out, v.resolve(variables))
+	}
+	return out
+}
+
+type objectValue map[string]value
+
+func (o objectValue) resolve(variables map[string]interface{}) interface{} {
+	out := make(map[string]interface{}, len(o))
+	for k, v := range o {
+		out[k] = v.resolve(variables)
+	}
+	return out
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+type parser struct {
+	src string
+	pos int
+	tok token
+}
+
+func parseDocument(src string) (operations []*operation, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if syntaxErr, ok := r.(syntaxError); ok {
+				err = syntaxErr
+				return
+			}
+			panic(r)
+		}
+	}()
+
+	p := &parser{src: src}
+	p.next()
+
+	for p.tok.kind != tokenEOF {
+		operations = append(operations, p.parseOperation())
+	}
+
+	if len(operations) == 0 {
+		p.fail("document does not contain an operation")
+	}
+
+	return operations, nil
+}
+
+type syntaxError string
+
+func (e syntaxError) Error() string { return string(e) }
+
+func (p *parser) fail(format string, args ...interface{}) {
+	panic(syntaxError(fmt.Sprintf("Invalid Syntax : "+format, args...)))
+}
+
+func (p *parser) parseOperation() *operation {
+	op := &operation{kind: "query", defaults: map[string]interface{}{}}
+
+	if p.tok.kind == tokenName {
+		switch p.tok.value {
+		case "query", "mutation":
+			op.kind = p.tok.value
+		case "fragment", "subscription":
+			p.fail("%s definitions are not supported", p.tok.value)
+		default:
+			p.fail("unexpected name '%s'", p.tok.value)
+		}
+		p.next()
+
+		if p.tok.kind == tokenName {
+			op.name = p.tok.value
+			p.next()
+		}
+
+		if p.is("(") {
+			p.parseVariableDefinitions(op)
+		}
+	}
+
+	op.selection = p.parseSelectionSet()
+	return op
+}
+
+func (p *parser) parseVariableDefinitions(op *operation) {
+	p.expect("(")
+	for !p.is(")") {
+		p.expect("$")
+		name := p.expectName()
+		p.expect(":")
+		p.parseType()
+		if p.is("=") {
+			p.next()
+			op.defaults[name] = p.parseValue(true).resolve(nil)
+		}
+	}
+	p.expect(")")
+}
+
+func (p *parser) parseType() {
+	if p.is("[") {
+		p.next()
+		p.parseType()
+		p.expect("]")
+	} else {
+		p.expectName()
+	}
+
+	if p.is("!") {
+		p.next()
+	}
+}
+
+func (p *parser) parseSelectionSet() []*field {
+	p.expect("{")
+
+	var fields []*field
+	for !p.is("}") {
+		if p.is("...") {
+			p.next()
+			if p.tok.kind != tokenName || p.tok.value != "on" {
+				p.fail("named fragments are not supported")
+			}
+			p.next()
+			typeCondition := p.expectName()
+			for _, f := range p.parseSelectionSet() {
+				f.typeCondition = typeCondition
+				fields = append(fields, f)
+			}
+			continue
+		}
+
+		fields = append(fields, p.parseField())
+	}
+	p.expect("}")
+
+	return fields
+}
+
+func (p *parser) parseField() *field {
+	f := &field{name: p.expectName(), arguments: map[string]value{}}
+
+	if p.is(":") {
+		p.next()
+		f.alias = f.name
+		f.name = p.expectName()
+	}
+
+	if p.is("(") {
+		p.next()
+		for !p.is(")") {
+			name := p.expectName()
+			p.expect(":")
+			f.arguments[name] = p.parseValue(false)
+		}
+		p.expect(")")
+	}
+
+	if p.is("@") {
+		p.fail("directives are not supported")
+	}
+
+	if p.is("{") {
+		f.selection = p.parseSelectionSet()
+	}
+
+	return f
+}
+
+func (p *parser) parseValue(constant bool) value {
+	tok := p.tok
+
+	switch {
+	case tok.kind == tokenPunctuator && tok.value == "$":
+		if constant {
+			p.fail("variables are not allowed in default values")
+		}
+		p.next()
+		return variable{name: p.expectName()}
+	case tok.kind == tokenPunctuator && tok.value == "[":
+		p.next()
+		list := listValue{}
+		for !p.is("]") {
+			list = append(list, p.parseValue(constant))
+		}
+		p.next()
+		return list
+	case tok.kind == tokenPunctuator && tok.value == "{":
+		p.next()
+		object := objectValue{}
+		for !p.is("}") {
+			name := p.expectName()
+			p.expect(":")
+			object[name] = p.parseValue(constant)
+		}
+		p.next()
+		return object
+	case tok.kind == tokenInt, tok.kind == tokenFloat:
+		p.next()
+		n, _ := strconv.ParseFloat(tok.value, 64)
+		return literal{n}
+	case tok.kind == tokenString:
+		p.next()
+		return literal{tok.value}
+	case tok.kind == tokenName:
+		p.next()
+		switch tok.value {
+		case "true":
+			return literal{true}
+		case "false":
+			return literal{false}
+		case "null":
+			return literal{nil}
+		}
+		// Enum values are passed to resolvers as strings.
+		return literal{tok.value}
+	}
+
+	p.fail("unexpected token '%s'", tok.value)
+	return nil
+}
+
+func (p *parser) is(punctuator string) bool {
+	return p.tok.kind == tokenPunctuator && p.tok.value == punctuator
+}
+
+func (p *parser) expect(punctuator string) {
+	if !p.is(punctuator) {
+		p.fail("expected '%s' but found '%s'", punctuator, p.tok.value)
+	}
+	p.next()
+}
+
+func (p *parser) expectName() string {
+	if p.tok.kind != tokenName {
+		p.fail("expected a name but found '%s'", p.tok.value)
+	}
+	name := p.tok.value
+	p.next()
+	return name
+}
+
+func (p *parser) next() {
+	p.skipIgnored()
+
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokenEOF, value: "<EOF>"}
+		return
+	}
+
+	c := p.src[p.pos]
+	switch {
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.tok = token{kind: tokenPunctuator, value: "..."}
+	case strings.IndexByte("!$():=@[]{}|", c) >= 0:
+		p.pos++
+		p.tok = token{kind: tokenPunctuator, value: string(c)}
+	case c == '_' || isLetter(c):
+		start := p.pos
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		p.tok = token{kind: tokenName, value: p.src[start:p.pos]}
+	case c == '-' || isDigit(c):
+		p.lexNumber()
+	case c == '"':
+		p.lexString()
+	default:
+		p.fail("unexpected character '%c'", c)
+	}
+}
+
+func (p *parser) skipIgnored() {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ' ', c == '\t', c == '\n', c == '\r', c == ',':
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case strings.HasPrefix(p.src[p.pos:], "\ufeff"):
+			p.pos += len("\ufeff")
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) lexNumber() {
+	start := p.pos
+	kind := tokenInt
+	if p.src[p.pos] == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case isDigit(c):
+		case c == '.' || c == 'e' || c == 'E' || c == '+' || (c == '-' && p.pos > start+1):
+			kind = tokenFloat
+		default:
+			p.tok = token{kind: kind, value: p.src[start:p.pos]}
+			return
+		}
+		p.pos++
+	}
+	p.tok = token{kind: kind, value: p.src[start:p.pos]}
+}
+
+func (p *parser) lexString() {
+	if strings.HasPrefix(p.src[p.pos:], `"""`) {
+		end := strings.Index(p.src[p.pos+3:], `"""`)
+		if end < 0 {
+			p.fail("unterminated block string")
+		}
+		p.tok = token{kind: tokenString, value: p.src[p.pos+3 : p.pos+3+end]}
+		p.pos += end + 6
+		return
+	}
+
+	var sb strings.Builder
+	p.pos++
+	for {
+		if p.pos >= len(p.src) || p.src[p.pos] == '\n' {
+			p.fail("unterminated string")
+		}
+
+		c := p.src[p.pos]
+		switch c {
+		case '"':
+			p.pos++
+			p.tok = token{kind: tokenString, value: sb.String()}
+			return
+		case '\\':
+			if p.pos+1 >= len(p.src) {
+				p.fail("unterminated string")
+			}
+			escaped := p.src[p.pos+1]
+			p.pos += 2
+			switch escaped {
+			case '"', '\\', '/':
+				sb.WriteByte(escaped)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if p.pos+4 > len(p.src) {
+					p.fail("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+				if err != nil {
+					p.fail("invalid unicode escape")
+				}
+				sb.WriteRune(rune(r))
+				p.pos += 4
+			default:
+				p.fail("invalid escape sequence '\\%c'", escaped)
+			}
+		default:
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			sb.WriteRune(r)
+			p.pos += size
+		}
+	}
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
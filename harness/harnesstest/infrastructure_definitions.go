@@ -0,0 +1,162 @@
+package harnesstest
+
+import "fmt"
+
+// infrastructureTypes maps the infrastructureType enum to the input field
+// holding the details of the infrastructure, the GraphQL type they are
+// returned as and the type of cloud provider they deploy through.
+var infrastructureTypes = map[string]struct {
+	input             string
+	typeName          string
+	cloudProviderType string
+}{
+	"KUBERNETES_DIRECT": {"kubernetesDirect", "KubernetesDirectInfrastructure", "KUBERNETES_CLUSTER"},
+	"AZURE_KUBERNETES":  {"azureKubernetes", "AzureKubernetesInfrastructure", "AZURE"},
+}
+
+// kubernetesDeploymentTypes are the deployment types infrastructure
+// definitions of a Kubernetes cluster support.
+var kubernetesDeploymentTypes = []string{"KUBERNETES", "HELM"}
+
+func (s *Server) registerInfrastructureDefinitions() {
+	s.queries["infrastructureDefinition"] = s.infrastructureDefinition
+	s.mutations["createInfrastructureDefinition"] = s.createInfrastructureDefinition
+	s.mutations["updateInfrastructureDefinition"] = s.updateInfrastructureDefinition
+	s.mutations["deleteInfrastructureDefinition"] = s.deleteInfrastructureDefinition
+}
+
+func infrastructureDefinitionNotFound() error {
+	return notFound("Infrastructure Definition does not exist")
+}
+
+func (s *Server) infrastructureDefinition(args map[string]interface{}) (interface{}, error) {
+	infra, ok := s.get("infrastructureDefinition", stringArg(args, "infrastructureDefinitionId"))
+	if !ok {
+		return nil, infrastructureDefinitionNotFound()
+	}
+	return infra, nil
+}
+
+// infrastructureDetails validates the details of an infrastructure definition
+// and returns them as stored.
+func (s *Server) infrastructureDetails(input map[string]interface{}) (map[string]interface{}, error) {
+	infrastructureType := stringArg(input, "infrastructureType")
+	kind, ok := infrastructureTypes[infrastructureType]
+	if !ok {
+		return nil, invalid("infrastructureType", fmt.Sprintf("Invalid request: unsupported infrastructure type %s", infrastructureType))
+	}
+
+	details, _ := input[kind.input].(map[string]interface{})
+	if details == nil {
+		return nil, invalid(kind.input, fmt.Sprintf("Invalid request: %s must be provided for infrastructure type %s", kind.input, infrastructureType))
+	}
+
+	cloudProviderID := stringArg(details, "cloudProviderId")
+	cp, ok := s.get("cloudProvider", cloudProviderID)
+	if !ok {
+		return nil, invalid("cloudProviderId", fmt.Sprintf("Invalid request: cloud provider %s does not exist", cloudProviderID))
+	}
+	if cp["cloudProviderType"] != kind.cloudProviderType {
+		return nil, invalid("cloudProviderId", fmt.Sprintf("Invalid request: infrastructure type %s needs a %s cloud provider", infrastructureType, kind.cloudProviderType))
+	}
+
+	stored := map[string]interface{}{
+		"__typename":  kind.typeName,
+		"releaseName": "release-${infra.kubernetes.infraId}",
+	}
+	merge(stored, details)
+
+	return stored, nil
+}
+
+// checkScopedServices makes sure the services an infrastructure definition is
+// scoped to belong to its application and share its deployment type.
+func (s *Server) checkScopedServices(infra map[string]interface{}, input map[string]interface{}) error {
+	services, _ := input["scopedServices"].([]interface{})
+	for _, id := range services {
+		svc, ok := s.get("service", fmt.Sprint(id))
+		if !ok || svc["applicationId"] != infra["applicationId"] {
+			return invalid("scopedServices", fmt.Sprintf("Invalid request: service %v does not exist in the application", id))
+		}
+		if svc["deploymentType"] != infra["deploymentType"] {
+			return invalid("scopedServices", fmt.Sprintf("Invalid request: service %v is not deployed with %v", id, infra["deploymentType"]))
+		}
+	}
+	return nil
+}
+
+func (s *Server) createInfrastructureDefinition(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	env, err := s.lookupAppEntity("environment", input, "environmentId", environmentNotFound)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameIn("infrastructureDefinition", "Infrastructure Definition", scope{"environmentId": env["id"]}, "", input); err != nil {
+		return nil, err
+	}
+	if !contains(kubernetesDeploymentTypes, stringArg(input, "deploymentType")) {
+		return nil, invalid("deploymentType", fmt.Sprintf("Invalid request: deployment type %s is not supported on Kubernetes", stringArg(input, "deploymentType")))
+	}
+
+	details, err := s.infrastructureDetails(input)
+	if err != nil {
+		return nil, err
+	}
+
+	infra := map[string]interface{}{
+		"id":             s.newID(),
+		"applicationId":  env["applicationId"],
+		"environmentId":  env["id"],
+		"deploymentType": input["deploymentType"],
+		"scopedServices": []interface{}{},
+	}
+	if err := s.checkScopedServices(infra, input); err != nil {
+		return nil, err
+	}
+
+	s.storeInfrastructureDefinition(infra, input, details)
+
+	return payload(input, "infrastructureDefinition", infra), nil
+}
+
+func (s *Server) updateInfrastructureDefinition(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	infra, err := s.lookupAppEntity("infrastructureDefinition", input, "infrastructureDefinitionId", infrastructureDefinitionNotFound)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameIn("infrastructureDefinition", "Infrastructure Definition", scope{"environmentId": infra["environmentId"]}, infra["id"].(string), input); err != nil {
+		return nil, err
+	}
+
+	details, err := s.infrastructureDetails(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkScopedServices(infra, input); err != nil {
+		return nil, err
+	}
+
+	s.storeInfrastructureDefinition(infra, input, details)
+
+	return payload(input, "infrastructureDefinition", infra), nil
+}
+
+func (s *Server) deleteInfrastructureDefinition(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	infra, err := s.lookupAppEntity("infrastructureDefinition", input, "infrastructureDefinitionId", infrastructureDefinitionNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove("infrastructureDefinition", infra["id"].(string))
+
+	return payload(input, "", nil), nil
+}
+
+func (s *Server) storeInfrastructureDefinition(infra map[string]interface{}, input map[string]interface{}, details map[string]interface{}) {
+	merge(infra, input, "clientMutationId", "applicationId", "environmentId", "infrastructureDefinitionId",
+		"deploymentType", "kubernetesDirect", "azureKubernetes")
+	infra["details"] = details
+	s.put("infrastructureDefinition", infra)
+}
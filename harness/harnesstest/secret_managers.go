@@ -0,0 +1,381 @@
+package harnesstest
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// secretManagerTypes maps the secretManagerType enum to the input field
+// holding the details of the secret manager, the GraphQL type it is returned
+// as and how its details are checked.
+var secretManagerTypes = map[string]struct {
+	input    string
+	typeName string
+	check    func(s *Server, sm map[string]interface{}, details map[string]interface{}) error
+}{
+	"HASHICORP_VAULT":     {"hashicorpVaultConfigInput", "HashicorpVaultSecretManager", (*Server).checkVaultSecretManager},
+	"AZURE_KEY_VAULT":     {"azureVaultConfigInput", "AzureVaultSecretManager", (*Server).checkAzureKeyVaultSecretManager},
+	"AWS_SECRETS_MANAGER": {"awsSecretsManagerConfigInput", "AwsSecretsManagerConfig", (*Server).checkAWSSecretsManager},
+	"AWS_KMS":             {"awsKmsConfigInput", "AwsKmsConfig", (*Server).checkAWSKMSSecretManager},
+	"GCP_KMS":             {"gcpKmsConfigInput", "GcpKmsConfig", (*Server).checkGCPKMSSecretManager},
+	"GCP_SECRETS_MANAGER": {"gcpSecretsManagerConfigInput", "GcpSecretsManagerConfig", (*Server).checkGCPSecretsManager},
+}
+
+// azureVaultName matches the names Azure accepts for key vaults.
+var azureVaultName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`)
+
+var (
+	awsRegion = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-[0-9]$`)
+	awsRole   = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`)
+	awsKMSKey = regexp.MustCompile(`^arn:aws[a-z-]*:kms:[a-z0-9-]+:[0-9]{12}:(key|alias)/.+$`)
+)
+
+var (
+	gcpProjectID = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+	gcpKMSName   = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,63}$`)
+)
+
+func (s *Server) registerSecretManagers() {
+	s.queries["secretManager"] = s.secretManager
+	s.queries["secretManagerByName"] = s.secretManagerByName
+	s.mutations["createSecretManager"] = s.createSecretManager
+	s.mutations["updateSecretManager"] = s.updateSecretManager
+	s.mutations["deleteSecretManager"] = s.deleteSecretManager
+}
+
+func secretManagerNotFound() error {
+	return notFound("Secret manager does not exist")
+}
+
+func (s *Server) secretManager(args map[string]interface{}) (interface{}, error) {
+	sm, ok := s.get("secretManager", stringArg(args, "secretManagerId"))
+	if !ok {
+		return nil, secretManagerNotFound()
+	}
+	return sm, nil
+}
+
+func (s *Server) secretManagerByName(args map[string]interface{}) (interface{}, error) {
+	sm, ok := s.findByName("secretManager", nil, stringArg(args, "name"))
+	if !ok {
+		return nil, secretManagerNotFound()
+	}
+	return sm, nil
+}
+
+// secretManagerDetails returns the details of the secret manager input
+// holds, checked against sm, which is nil on creation.
+func (s *Server) secretManagerDetails(sm map[string]interface{}, input map[string]interface{}) (string, map[string]interface{}, error) {
+	secretManagerType := stringArg(input, "secretManagerType")
+	kind, ok := secretManagerTypes[secretManagerType]
+	if !ok {
+		return "", nil, invalid("secretManagerType", fmt.Sprintf("Invalid request: unsupported secret manager type %s", secretManagerType))
+	}
+
+	details, _ := input[kind.input].(map[string]interface{})
+	if details == nil {
+		return "", nil, invalid(kind.input, fmt.Sprintf("Invalid request: %s must be provided for secret manager type %s", kind.input, secretManagerType))
+	}
+
+	if err := kind.check(s, sm, details); err != nil {
+		return "", nil, err
+	}
+
+	return kind.typeName, details, nil
+}
+
+func (s *Server) createSecretManager(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	typeName, details, err := s.secretManagerDetails(nil, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkName("secretManager", "Secret manager", "", details); err != nil {
+		return nil, err
+	}
+
+	sm := map[string]interface{}{
+		"id":                s.newID(),
+		"secretManagerType": input["secretManagerType"],
+		"usageScope":        nil,
+		"isDefault":         false,
+		"__typename":        typeName,
+	}
+	s.storeSecretManager(sm, details)
+
+	return payload(input, "secretManager", sm), nil
+}
+
+func (s *Server) updateSecretManager(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "secretManagerId")
+
+	sm, ok := s.get("secretManager", id)
+	if !ok {
+		return nil, secretManagerNotFound()
+	}
+	if input["secretManagerType"] != sm["secretManagerType"] {
+		return nil, invalid("secretManagerType", "Invalid request: the type of a secret manager cannot be changed")
+	}
+
+	_, details, err := s.secretManagerDetails(sm, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkName("secretManager", "Secret manager", id, details); err != nil {
+		return nil, err
+	}
+
+	s.storeSecretManager(sm, details)
+
+	return payload(input, "secretManager", sm), nil
+}
+
+func (s *Server) deleteSecretManager(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "secretManagerId")
+
+	sm, ok := s.get("secretManager", id)
+	if !ok {
+		return nil, secretManagerNotFound()
+	}
+
+	for _, secret := range s.list("secret") {
+		if secret["secretManagerId"] == id {
+			return nil, invalid("secretManagerId", fmt.Sprintf("Invalid request: secret manager %s still holds secrets", sm["name"]))
+		}
+	}
+
+	s.remove("secretManager", id)
+
+	return payload(input, "", nil), nil
+}
+
+// storeSecretManager saves the details of a secret manager, keeping the
+// credentials it authenticates with out of the entity as Harness.io never
+// returns them. A new default secret manager replaces the previous one.
+func (s *Server) storeSecretManager(sm map[string]interface{}, details map[string]interface{}) {
+	if auth, ok := details["authDetails"].(map[string]interface{}); ok {
+		sm["appRoleId"] = auth["appRoleId"]
+	}
+
+	merge(sm, details, "authDetails")
+	s.put("secretManager", sm)
+
+	if sm["isDefault"] == true {
+		for _, other := range s.list("secretManager") {
+			if other["id"] != sm["id"] {
+				other["isDefault"] = false
+			}
+		}
+	}
+}
+
+// checkVaultSecretManager validates the address, secret engine and
+// credentials of a Vault secret manager. sm is nil on creation.
+func (s *Server) checkVaultSecretManager(sm map[string]interface{}, details map[string]interface{}) error {
+	if sm == nil {
+		u, err := url.Parse(stringArg(details, "vaultUrl"))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return invalid("vaultUrl", "Invalid request: vaultUrl must be an http or https URL")
+		}
+		if stringArg(details, "secretEngineName") == "" {
+			return invalid("secretEngineName", "Invalid request: secretEngineName cannot be empty")
+		}
+		if version, _ := intArg(details, "secretEngineVersion"); version != 1 && version != 2 {
+			return invalid("secretEngineVersion", "Invalid request: secretEngineVersion must be 1 or 2")
+		}
+	}
+
+	if interval, ok := intArg(details, "secretEngineRenewalInterval"); ok && interval < 0 {
+		return invalid("secretEngineRenewalInterval", "Invalid request: secretEngineRenewalInterval cannot be negative")
+	}
+
+	auth, _ := details["authDetails"].(map[string]interface{})
+	if auth == nil {
+		if sm == nil {
+			return invalid("authDetails", "Invalid request: authDetails cannot be empty")
+		}
+		return nil
+	}
+
+	token := stringArg(auth, "authToken")
+	roleID := stringArg(auth, "appRoleId")
+	switch {
+	case token != "" && roleID != "":
+		return invalid("authDetails", "Invalid request: authDetails must hold either an authToken or an AppRole, not both")
+	case roleID != "" && stringArg(auth, "secretId") == "":
+		return invalid("secretId", "Invalid request: secretId cannot be empty when authenticating with an AppRole")
+	case token == "" && roleID == "":
+		return invalid("authDetails", "Invalid request: authDetails must hold either an authToken or an AppRole")
+	}
+
+	return nil
+}
+
+// checkAzureKeyVaultSecretManager validates the vault and the application of
+// an Azure Key Vault secret manager. sm is nil on creation.
+func (s *Server) checkAzureKeyVaultSecretManager(sm map[string]interface{}, details map[string]interface{}) error {
+	if sm == nil {
+		if !azureVaultName.MatchString(stringArg(details, "vaultName")) {
+			return invalid("vaultName", "Invalid request: vaultName must be 3 to 24 letters, digits and hyphens")
+		}
+		for _, field := range []string{"subscription", "clientId", "tenantId", "clientSecretId"} {
+			if stringArg(details, field) == "" {
+				return invalid(field, fmt.Sprintf("Invalid request: %s cannot be empty", field))
+			}
+		}
+		if details["azureEnvironmentType"] == nil {
+			details["azureEnvironmentType"] = "AZURE"
+		}
+	}
+
+	secretID := stringArg(details, "clientSecretId")
+	if secretID == "" {
+		return nil
+	}
+	if err := s.checkSecretRef("clientSecretId", secretID, "ENCRYPTED_TEXT"); err != nil {
+		return err
+	}
+	if secret, _ := s.get("secret", secretID); sm != nil && secret["secretManagerId"] == sm["id"] {
+		return invalid("clientSecretId", "Invalid request: the secret of the client cannot be stored in the secret manager itself")
+	}
+
+	return nil
+}
+
+// checkAWSSecretsManager validates the region and credentials of an AWS
+// Secrets Manager secret manager. sm is nil on creation.
+func (s *Server) checkAWSSecretsManager(sm map[string]interface{}, details map[string]interface{}) error {
+	if sm == nil && !awsRegion.MatchString(stringArg(details, "region")) {
+		return invalid("region", "Invalid request: region must be an AWS region such as us-east-1")
+	}
+
+	return s.checkAWSCredentials(sm, details)
+}
+
+// checkAWSKMSSecretManager validates the region, key and credentials of an
+// AWS KMS secret manager. sm is nil on creation.
+func (s *Server) checkAWSKMSSecretManager(sm map[string]interface{}, details map[string]interface{}) error {
+	if sm == nil {
+		if !awsRegion.MatchString(stringArg(details, "region")) {
+			return invalid("region", "Invalid request: region must be an AWS region such as us-east-1")
+		}
+		if !awsKMSKey.MatchString(stringArg(details, "kmsArn")) {
+			return invalid("kmsArn", "Invalid request: kmsArn must be the ARN of a KMS key or alias")
+		}
+	}
+
+	return s.checkAWSCredentials(sm, details)
+}
+
+// checkAWSCredentials validates the credentials of an AWS secret manager
+// against its delegate selectors, which the roles are assumed on. Either may
+// be left out of an update, in which case the stored ones are kept.
+func (s *Server) checkAWSCredentials(sm map[string]interface{}, details map[string]interface{}) error {
+	credentials, _ := details["credentials"].(map[string]interface{})
+	if credentials == nil {
+		if sm == nil {
+			return invalid("credentials", "Invalid request: credentials cannot be empty")
+		}
+		credentials, _ = sm["credentials"].(map[string]interface{})
+	}
+
+	selectors, ok := details["delegateSelectors"].([]interface{})
+	if !ok && sm != nil {
+		selectors, _ = sm["delegateSelectors"].([]interface{})
+	}
+
+	switch credentialType := stringArg(credentials, "credentialType"); credentialType {
+	case "ACCESS_KEY":
+		if stringArg(credentials, "accessKey") == "" {
+			return invalid("accessKey", "Invalid request: accessKey cannot be empty")
+		}
+		secretID := stringArg(credentials, "secretKeySecretId")
+		if err := s.checkSecretRef("secretKeySecretId", secretID, "ENCRYPTED_TEXT"); err != nil {
+			return err
+		}
+		if secret, _ := s.get("secret", secretID); sm != nil && secret["secretManagerId"] == sm["id"] {
+			return invalid("secretKeySecretId", "Invalid request: the secret key cannot be stored in the secret manager itself")
+		}
+	case "ASSUME_IAM_ROLE", "ASSUME_STS_ROLE":
+		if len(selectors) == 0 {
+			return invalid("delegateSelectors", fmt.Sprintf("Invalid request: delegateSelectors cannot be empty with credentials of type %s", credentialType))
+		}
+		if credentialType == "ASSUME_IAM_ROLE" {
+			break
+		}
+		if !awsRole.MatchString(stringArg(credentials, "roleArn")) {
+			return invalid("roleArn", "Invalid request: roleArn must be the ARN of an IAM role")
+		}
+		duration, ok := intArg(credentials, "assumeStsRoleDuration")
+		if !ok {
+			credentials["assumeStsRoleDuration"] = 900
+		} else if duration < 900 || duration > 43200 {
+			return invalid("assumeStsRoleDuration", "Invalid request: assumeStsRoleDuration must be between 900 and 43200 seconds")
+		}
+	default:
+		return invalid("credentialType", fmt.Sprintf("Invalid request: unsupported credential type %s", credentialType))
+	}
+
+	return nil
+}
+
+// checkGCPKMSSecretManager validates the key and the service account of a
+// GCP KMS secret manager. sm is nil on creation.
+func (s *Server) checkGCPKMSSecretManager(sm map[string]interface{}, details map[string]interface{}) error {
+	if sm == nil {
+		if !gcpProjectID.MatchString(stringArg(details, "projectId")) {
+			return invalid("projectId", "Invalid request: projectId must be the id of a GCP project")
+		}
+		if stringArg(details, "region") == "" {
+			return invalid("region", "Invalid request: region cannot be empty")
+		}
+		for _, field := range []string{"keyRing", "keyName"} {
+			if !gcpKMSName.MatchString(stringArg(details, field)) {
+				return invalid(field, fmt.Sprintf("Invalid request: %s must be 1 to 63 letters, digits, hyphens and underscores", field))
+			}
+		}
+	}
+
+	_, err := s.checkGCPServiceAccountKey(sm, details)
+	return err
+}
+
+// checkGCPSecretsManager validates the service account of a GCP Secret
+// Manager secret manager, whose project the secrets are stored in. sm is nil
+// on creation.
+func (s *Server) checkGCPSecretsManager(sm map[string]interface{}, details map[string]interface{}) error {
+	projectID, err := s.checkGCPServiceAccountKey(sm, details)
+	if err != nil {
+		return err
+	}
+	if projectID != "" {
+		details["projectId"] = projectID
+	}
+
+	return nil
+}
+
+// checkGCPServiceAccountKey checks credentialsFileSecretId refers to an
+// encrypted file holding the JSON key of a service account, returning the
+// project of the service account. It returns no project when an update
+// leaves the key out.
+func (s *Server) checkGCPServiceAccountKey(sm map[string]interface{}, details map[string]interface{}) (string, error) {
+	secretID := stringArg(details, "credentialsFileSecretId")
+	if secretID == "" {
+		if sm == nil {
+			return "", invalid("credentialsFileSecretId", "Invalid request: credentialsFileSecretId cannot be empty")
+		}
+		return "", nil
+	}
+	if err := s.checkSecretRef("credentialsFileSecretId", secretID, "ENCRYPTED_FILE"); err != nil {
+		return "", err
+	}
+	if secret, _ := s.get("secret", secretID); sm != nil && secret["secretManagerId"] == sm["id"] {
+		return "", invalid("credentialsFileSecretId", "Invalid request: the key of the service account cannot be stored in the secret manager itself")
+	}
+
+	return s.serviceAccountProject("credentialsFileSecretId", secretID)
+}
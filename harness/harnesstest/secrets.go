@@ -0,0 +1,150 @@
+package harnesstest
+
+import (
+	"encoding/base64"
+	"fmt"
+)
+
+// secretTypes maps the secretType enum to the input field holding the
+// details of the secret, the GraphQL type it is returned as and, for
+// credentials, how its details are checked.
+var secretTypes = map[string]struct {
+	input    string
+	typeName string
+	check    func(s *Server, secret map[string]interface{}, details map[string]interface{}) error
+}{
+	"ENCRYPTED_TEXT":   {"encryptedText", "EncryptedText", nil},
+	"ENCRYPTED_FILE":   {"encryptedFile", "EncryptedFile", nil},
+	"SSH_CREDENTIAL":   {"sshCredential", "SSHCredential", (*Server).checkSSHCredential},
+	"WINRM_CREDENTIAL": {"winRMCredential", "WinRMCredential", (*Server).checkWinRMCredential},
+}
+
+func (s *Server) registerSecrets() {
+	s.queries["secret"] = s.secret
+	s.queries["secrets"] = s.listSecrets
+	s.mutations["createSecret"] = s.createSecret
+	s.mutations["updateSecret"] = s.updateSecret
+	s.mutations["deleteSecret"] = s.deleteSecret
+}
+
+func secretNotFound() error {
+	return notFound("No secret exists with given input")
+}
+
+func (s *Server) lookupSecret(id string, secretType string) (map[string]interface{}, error) {
+	secret, ok := s.get("secret", id)
+	if !ok || secret["secretType"] != secretType {
+		return nil, secretNotFound()
+	}
+	return secret, nil
+}
+
+func (s *Server) secret(args map[string]interface{}) (interface{}, error) {
+	return s.lookupSecret(stringArg(args, "secretId"), stringArg(args, "secretType"))
+}
+
+func (s *Server) listSecrets(args map[string]interface{}) (interface{}, error) {
+	return s.connection("secret", args, filterFields{
+		"secret":        "id",
+		"secretType":    "secretType",
+		"secretManager": "secretManagerId",
+	})
+}
+
+// secretDetails returns the details of the secret input holds, checked
+// against secret, which is nil on creation.
+func (s *Server) secretDetails(secret map[string]interface{}, input map[string]interface{}) (string, map[string]interface{}, error) {
+	secretType := stringArg(input, "secretType")
+	kind, ok := secretTypes[secretType]
+	if !ok {
+		return "", nil, invalid("secretType", fmt.Sprintf("Invalid request: unsupported secret type %s", secretType))
+	}
+
+	details, _ := input[kind.input].(map[string]interface{})
+	if details == nil {
+		return "", nil, invalid(kind.input, fmt.Sprintf("Invalid request: %s must be provided for secret type %s", kind.input, secretType))
+	}
+
+	if content, ok := details["content"].(string); ok {
+		if _, err := base64.StdEncoding.DecodeString(content); err != nil {
+			return "", nil, invalid("content", "Invalid request: content must be base64 encoded")
+		}
+	}
+
+	if kind.check != nil {
+		if err := kind.check(s, secret, details); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return kind.typeName, details, nil
+}
+
+func (s *Server) createSecret(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	typeName, details, err := s.secretDetails(nil, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkName("secret", "Secret", "", details); err != nil {
+		return nil, err
+	}
+
+	secret := map[string]interface{}{
+		"id":         s.newID(),
+		"secretType": input["secretType"],
+		"__typename": typeName,
+	}
+	s.storeSecret(secret, details)
+
+	return payload(input, "secret", secret), nil
+}
+
+func (s *Server) updateSecret(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	secret, err := s.lookupSecret(stringArg(input, "secretId"), stringArg(input, "secretType"))
+	if err != nil {
+		return nil, err
+	}
+
+	_, details, err := s.secretDetails(secret, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkName("secret", "Secret", secret["id"].(string), details); err != nil {
+		return nil, err
+	}
+
+	s.storeSecret(secret, details)
+
+	return payload(input, "secret", secret), nil
+}
+
+func (s *Server) deleteSecret(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	secret, err := s.lookupSecret(stringArg(input, "secretId"), stringArg(input, "secretType"))
+	if err != nil {
+		return nil, err
+	}
+
+	id := secret["id"].(string)
+	s.remove("secret", id)
+	delete(s.secrets, id)
+
+	return payload(input, "", nil), nil
+}
+
+// storeSecret saves the details of a secret, keeping its value or the
+// content of its file out of the entity as Harness.io never returns them.
+func (s *Server) storeSecret(secret map[string]interface{}, details map[string]interface{}) {
+	if value, ok := details["value"].(string); ok {
+		s.secrets[secret["id"].(string)] = value
+	}
+	if content, ok := details["content"].(string); ok {
+		decoded, _ := base64.StdEncoding.DecodeString(content)
+		s.secrets[secret["id"].(string)] = string(decoded)
+	}
+
+	merge(secret, details, "value", "content")
+	s.put("secret", secret)
+}
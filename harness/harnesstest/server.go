@@ -0,0 +1,300 @@
+// Package harnesstest provides an in-memory stand-in for the Harness.io
+// GraphQL API, so the harness client and the provider resources can be
+// exercised without a Harness account.
+package harnesstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+)
+
+// APIKey is the only API key the server accepts.
+const APIKey = "harnesstest-api-key"
+
+// Token is the only bearer token the server accepts.
+const Token = "harnesstest-token"
+
+// resolver executes a single root field of an operation.
+type resolver func(args map[string]interface{}) (interface{}, error)
+
+// Server is an httptest.Server answering the GraphQL operations used by the
+// harness client from an in-memory store.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	entities map[string]map[string]map[string]interface{}
+	secrets  map[string]string
+	yaml     map[string]string
+	nextID   int
+	failures []failure
+
+	queries   map[string]resolver
+	mutations map[string]resolver
+}
+
+type failure struct {
+	statusCode int
+	retryAfter time.Duration
+}
+
+// NewServer starts a server with an empty store. Callers must Close it.
+func NewServer() *Server {
+	s := &Server{
+		entities:  map[string]map[string]map[string]interface{}{},
+		secrets:   map[string]string{},
+		yaml:      map[string]string{},
+		queries:   map[string]resolver{},
+		mutations: map[string]resolver{},
+	}
+
+	s.registerApplications()
+	s.registerSecrets()
+	s.registerCloudProviders()
+	s.registerServices()
+	s.registerEnvironments()
+	s.registerInfrastructureDefinitions()
+	s.registerTriggers()
+	s.registerUserGroups()
+	s.registerUsers()
+	s.registerSecretManagers()
+
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Client returns a harness client talking to the server. Retries back off
+// for a millisecond at most so tests exercising failures stay fast.
+func (s *Server) Client(opts ...harness.ClientOption) *harness.Client {
+	opts = append([]harness.ClientOption{harness.WithRetry(3, time.Millisecond, time.Millisecond)}, opts...)
+	return harness.NewClient(APIKey, s.URL, opts...)
+}
+
+// FailNext makes the next count requests fail with statusCode before they
+// reach the GraphQL layer, optionally asking the client to wait retryAfter.
+func (s *Server) FailNext(count int, statusCode int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := 0; i < count; i++ {
+		s.failures = append(s.failures, failure{statusCode: statusCode, retryAfter: retryAfter})
+	}
+}
+
+// Entity returns a copy of a stored entity of the given kind, such as
+// "application", "secret" or "cloudProvider".
+func (s *Server) Entity(kind string, id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entity, ok := s.entities[kind][id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyMap(entity), true
+}
+
+// SecretValue returns the plaintext stored for a secret, which Harness.io
+// never returns through the API.
+func (s *Server) SecretValue(id string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.secrets[id]
+	return value, ok
+}
+
+type request struct {
+	OperationName string                 `json:"operationName"`
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []*graphQLError        `json:"errors,omitempty"`
+}
+
+type graphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+func (e *graphQLError) Error() string {
+	return e.Message
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if f, ok := s.nextFailure(); ok {
+		if f.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(f.retryAfter.Seconds())))
+		}
+		http.Error(w, http.StatusText(f.statusCode), f.statusCode)
+		return
+	}
+
+	if r.Header.Get("x-api-key") != APIKey && r.Header.Get("Authorization") != "Bearer "+Token {
+		writeJSON(w, http.StatusUnauthorized, &response{Errors: []*graphQLError{{Message: "Invalid API key"}}})
+		return
+	}
+
+	if strings.Contains(r.URL.Path, yamlPathPrefix) {
+		s.serveYAML(w, r)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req := &request{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeJSON(w, http.StatusBadRequest, &response{Errors: []*graphQLError{{Message: "Invalid request body: " + err.Error()}}})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.execute(req))
+}
+
+func (s *Server) nextFailure() (failure, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.failures) == 0 {
+		return failure{}, false
+	}
+
+	f := s.failures[0]
+	s.failures = s.failures[1:]
+	return f, true
+}
+
+func (s *Server) execute(req *request) *response {
+	operations, err := parseDocument(req.Query)
+	if err != nil {
+		return &response{Errors: []*graphQLError{{Message: err.Error()}}}
+	}
+
+	op, err := selectOperation(operations, req.OperationName)
+	if err != nil {
+		return &response{Errors: []*graphQLError{{Message: err.Error()}}}
+	}
+
+	variables := map[string]interface{}{}
+	for k, v := range op.defaults {
+		variables[k] = v
+	}
+	for k, v := range req.Variables {
+		variables[k] = v
+	}
+
+	resolvers, typeName := s.queries, "Query"
+	if op.kind == "mutation" {
+		resolvers, typeName = s.mutations, "Mutation"
+	}
+
+	res := &response{Data: map[string]interface{}{}}
+	for _, f := range op.selection {
+		key := f.responseKey()
+
+		resolve, ok := resolvers[f.name]
+		if !ok {
+			return &response{Errors: []*graphQLError{{
+				Message: fmt.Sprintf("Validation error of type FieldUndefined: Field '%s' in type '%s' is undefined", f.name, typeName),
+			}}}
+		}
+
+		args := map[string]interface{}{}
+		for name, v := range f.arguments {
+			args[name] = v.resolve(variables)
+		}
+
+		s.mu.Lock()
+		result, err := resolve(args)
+		if err == nil {
+			res.Data[key] = project(result, f.selection)
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			gqlErr, ok := err.(*graphQLError)
+			if !ok {
+				gqlErr = &graphQLError{Message: err.Error()}
+			}
+			gqlErr.Path = []interface{}{key}
+			res.Errors = append(res.Errors, gqlErr)
+			res.Data[key] = nil
+		}
+	}
+
+	return res
+}
+
+func selectOperation(operations []*operation, name string) (*operation, error) {
+	if name == "" {
+		if len(operations) > 1 {
+			return nil, fmt.Errorf("Must provide operation name if query contains multiple operations")
+		}
+		return operations[0], nil
+	}
+
+	for _, op := range operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unknown operation named '%s'", name)
+}
+
+// project keeps only the fields of result listed in selection, renamed to
+// their aliases.
+func project(result interface{}, selection []*field) interface{} {
+	switch v := result.(type) {
+	case map[string]interface{}:
+		if selection == nil {
+			return copyMap(v)
+		}
+
+		out := map[string]interface{}{}
+		for _, f := range selection {
+			if f.typeCondition != "" && f.typeCondition != v["__typename"] {
+				continue
+			}
+			out[f.responseKey()] = project(v[f.name], f.selection)
+		}
+		return out
+	case []map[string]interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			out = append(out, project(item, selection))
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			out = append(out, project(item, selection))
+		}
+		return out
+	}
+
+	return result
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
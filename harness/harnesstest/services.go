@@ -0,0 +1,96 @@
+package harnesstest
+
+func (s *Server) registerServices() {
+	s.queries["service"] = s.service
+	s.mutations["createService"] = s.createService
+	s.mutations["updateService"] = s.updateService
+	s.mutations["deleteService"] = s.deleteService
+}
+
+func serviceNotFound() error {
+	return notFound("Service does not exist")
+}
+
+// lookupApplication returns the application an entity is created in,
+// failing the way Harness.io does when it does not exist.
+func (s *Server) lookupApplication(input map[string]interface{}) (string, error) {
+	appID := stringArg(input, "applicationId")
+	if _, ok := s.get("application", appID); !ok {
+		return "", applicationNotAuthorized()
+	}
+	return appID, nil
+}
+
+// lookupAppEntity returns an entity of an application.
+func (s *Server) lookupAppEntity(kind string, input map[string]interface{}, idField string, notFound func() error) (map[string]interface{}, error) {
+	appID, err := s.lookupApplication(input)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, ok := s.get(kind, stringArg(input, idField))
+	if !ok || entity["applicationId"] != appID {
+		return nil, notFound()
+	}
+	return entity, nil
+}
+
+func (s *Server) service(args map[string]interface{}) (interface{}, error) {
+	svc, ok := s.get("service", stringArg(args, "serviceId"))
+	if !ok {
+		return nil, serviceNotFound()
+	}
+	return svc, nil
+}
+
+func (s *Server) createService(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	appID, err := s.lookupApplication(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameIn("service", "Service", scope{"applicationId": appID}, "", input); err != nil {
+		return nil, err
+	}
+	if stringArg(input, "deploymentType") == "" {
+		return nil, invalid("deploymentType", "Invalid request: deploymentType cannot be empty")
+	}
+
+	svc := map[string]interface{}{
+		"id":           s.newID(),
+		"description":  nil,
+		"artifactType": nil,
+		"tags":         []interface{}{},
+	}
+	merge(svc, input, "clientMutationId")
+	s.put("service", svc)
+
+	return payload(input, "service", svc), nil
+}
+
+func (s *Server) updateService(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	svc, err := s.lookupAppEntity("service", input, "serviceId", serviceNotFound)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameIn("service", "Service", scope{"applicationId": svc["applicationId"]}, svc["id"].(string), input); err != nil {
+		return nil, err
+	}
+
+	merge(svc, input, "clientMutationId", "applicationId", "serviceId")
+
+	return payload(input, "service", svc), nil
+}
+
+func (s *Server) deleteService(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	svc, err := s.lookupAppEntity("service", input, "serviceId", serviceNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove("service", svc["id"].(string))
+
+	return payload(input, "", nil), nil
+}
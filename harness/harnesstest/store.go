@@ -0,0 +1,175 @@
+package harnesstest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The store keeps entities as the JSON objects Harness.io would return for
+// them, keyed by kind and id. All of these are called with s.mu held.
+
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("hts%019d", s.nextID)
+}
+
+func (s *Server) put(kind string, entity map[string]interface{}) {
+	if s.entities[kind] == nil {
+		s.entities[kind] = map[string]map[string]interface{}{}
+	}
+	s.entities[kind][entity["id"].(string)] = entity
+}
+
+func (s *Server) get(kind string, id string) (map[string]interface{}, bool) {
+	entity, ok := s.entities[kind][id]
+	return entity, ok
+}
+
+func (s *Server) remove(kind string, id string) bool {
+	if _, ok := s.entities[kind][id]; !ok {
+		return false
+	}
+	delete(s.entities[kind], id)
+	return true
+}
+
+// list returns the entities of a kind sorted by id, which is also the order
+// they were created in.
+func (s *Server) list(kind string) []map[string]interface{} {
+	ids := make([]string, 0, len(s.entities[kind]))
+	for id := range s.entities[kind] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	out := make([]map[string]interface{}, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, s.entities[kind][id])
+	}
+	return out
+}
+
+// removeChildren removes the entities whose field refers to a deleted
+// parent, such as the services of an application through applicationId, as
+// Harness.io does.
+func (s *Server) removeChildren(field string, parentID string) {
+	for _, entities := range s.entities {
+		for id, entity := range entities {
+			if entity[field] == parentID {
+				delete(entities, id)
+			}
+		}
+	}
+}
+
+// scope restricts a lookup to the entities having the given field values,
+// such as the entities of an application.
+type scope map[string]interface{}
+
+func (sc scope) contains(entity map[string]interface{}) bool {
+	for field, value := range sc {
+		if entity[field] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) findByName(kind string, within scope, name string) (map[string]interface{}, bool) {
+	for _, entity := range s.entities[kind] {
+		if entity["name"] == name && within.contains(entity) {
+			return entity, true
+		}
+	}
+	return nil, false
+}
+
+// checkName validates the name of a new or renamed entity.
+func (s *Server) checkName(kind string, label string, id string, input map[string]interface{}) error {
+	return s.checkNameIn(kind, label, nil, id, input)
+}
+
+// checkNameIn validates the name of a new or renamed entity, which only has
+// to be unique within a scope such as its application.
+func (s *Server) checkNameIn(kind string, label string, within scope, id string, input map[string]interface{}) error {
+	name, ok := input["name"].(string)
+	if !ok {
+		if id != "" {
+			return nil
+		}
+		return invalid("name", "Invalid request: name cannot be empty")
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return invalid("name", "Invalid request: name cannot be empty")
+	}
+
+	if existing, ok := s.findByName(kind, within, name); ok && existing["id"] != id {
+		return &graphQLError{Message: fmt.Sprintf("%s with the name '%s' already exists", label, name)}
+	}
+
+	return nil
+}
+
+func invalid(field string, message string) error {
+	return &graphQLError{
+		Message: message,
+		Extensions: map[string]interface{}{
+			"code":  "INVALID_REQUEST",
+			"field": field,
+		},
+	}
+}
+
+func notFound(message string) error {
+	return &graphQLError{Message: message}
+}
+
+func inputArg(args map[string]interface{}) map[string]interface{} {
+	input, _ := args["input"].(map[string]interface{})
+	if input == nil {
+		return map[string]interface{}{}
+	}
+	return input
+}
+
+func stringArg(m map[string]interface{}, key string) string {
+	v, _ := m[key].(string)
+	return v
+}
+
+// merge copies every field of input that is set into entity, skipping keys.
+func merge(entity map[string]interface{}, input map[string]interface{}, skip ...string) {
+	for k, v := range input {
+		if v == nil || contains(skip, k) {
+			continue
+		}
+		entity[k] = v
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+func payload(input map[string]interface{}, key string, entity map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{"clientMutationId": input["clientMutationId"]}
+	if key != "" {
+		out[key] = entity
+	}
+	return out
+}
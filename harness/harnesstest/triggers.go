@@ -0,0 +1,325 @@
+package harnesstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// triggerConditions maps the conditionType enum to the input field holding
+// the condition, the type of condition it is returned as and the GraphQL
+// type of the condition.
+var triggerConditions = map[string]struct {
+	input         string
+	conditionType string
+	typeName      string
+}{
+	"ON_NEW_ARTIFACT":        {"artifactConditionInput", "NEW_ARTIFACT", "OnNewArtifact"},
+	"ON_PIPELINE_COMPLETION": {"pipelineConditionInput", "PIPELINE_COMPLETION", "OnPipelineCompletion"},
+	"ON_SCHEDULE":            {"scheduleConditionInput", "SCHEDULED", "OnSchedule"},
+	"ON_WEBHOOK":             {"webhookConditionInput", "WEBHOOK", "OnWebhook"},
+}
+
+// webhookEvents maps each webhook source to the input field holding its
+// event, custom webhooks having none.
+var webhookEvents = map[string]string{
+	"GITHUB":    "githubEvent",
+	"GITLAB":    "gitlabEvent",
+	"BITBUCKET": "bitbucketEvent",
+	"CUSTOM":    "",
+}
+
+// artifactSelections maps the artifactSelectionType enum to the GraphQL type
+// of the selection, the field it requires and the condition it needs, if any.
+var artifactSelections = map[string]struct {
+	typeName  string
+	required  string
+	condition string
+}{
+	"FROM_TRIGGERING_ARTIFACT": {"FromTriggeringArtifactSource", "", "ON_NEW_ARTIFACT"},
+	"FROM_TRIGGERING_PIPELINE": {"FromTriggeringPipeline", "", "ON_PIPELINE_COMPLETION"},
+	"FROM_PAYLOAD_SOURCE":      {"FromWebhookPayload", "artifactSourceId", "ON_WEBHOOK"},
+	"LAST_COLLECTED":           {"LastCollected", "artifactSourceId", ""},
+	"LAST_DEPLOYED_WORKFLOW":   {"LastDeployedFromWorkflow", "workflowId", ""},
+	"LAST_DEPLOYED_PIPELINE":   {"LastDeployedFromPipeline", "pipelineId", ""},
+}
+
+func (s *Server) registerTriggers() {
+	s.queries["trigger"] = s.trigger
+	s.mutations["createTrigger"] = s.createTrigger
+	s.mutations["updateTrigger"] = s.updateTrigger
+	s.mutations["deleteTrigger"] = s.deleteTrigger
+}
+
+func triggerNotFound() error {
+	return notFound("Trigger does not exist")
+}
+
+func (s *Server) trigger(args map[string]interface{}) (interface{}, error) {
+	trigger, ok := s.get("trigger", stringArg(args, "triggerId"))
+	if !ok {
+		return nil, triggerNotFound()
+	}
+	return trigger, nil
+}
+
+// triggerCondition validates the condition of a trigger and returns it as
+// stored. Webhooks keep the token they were given when first created.
+func (s *Server) triggerCondition(trigger map[string]interface{}, input map[string]interface{}) (map[string]interface{}, error) {
+	conditionInput, _ := input["condition"].(map[string]interface{})
+	conditionType := stringArg(conditionInput, "conditionType")
+	kind, ok := triggerConditions[conditionType]
+	if !ok {
+		return nil, invalid("condition", fmt.Sprintf("Invalid request: unsupported condition type %s", conditionType))
+	}
+
+	details, _ := conditionInput[kind.input].(map[string]interface{})
+	if details == nil {
+		return nil, invalid(kind.input, fmt.Sprintf("Invalid request: %s must be provided for condition type %s", kind.input, conditionType))
+	}
+
+	condition := map[string]interface{}{
+		"__typename":           kind.typeName,
+		"triggerConditionType": kind.conditionType,
+	}
+
+	switch conditionType {
+	case "ON_NEW_ARTIFACT":
+		if stringArg(details, "artifactSourceId") == "" {
+			return nil, invalid("artifactSourceId", "Invalid request: artifactSourceId cannot be empty")
+		}
+		merge(condition, details)
+	case "ON_PIPELINE_COMPLETION":
+		if stringArg(details, "pipelineId") == "" {
+			return nil, invalid("pipelineId", "Invalid request: pipelineId cannot be empty")
+		}
+		merge(condition, details)
+	case "ON_SCHEDULE":
+		cron := stringArg(details, "cronExpression")
+		if fields := len(strings.Fields(cron)); fields < 5 || fields > 7 {
+			return nil, invalid("cronExpression", fmt.Sprintf("Invalid request: %q is not a valid cron expression", cron))
+		}
+		merge(condition, details)
+		condition["cronDescription"] = "Runs on the schedule " + cron
+	case "ON_WEBHOOK":
+		return s.webhookCondition(trigger, condition, details)
+	}
+
+	return condition, nil
+}
+
+func (s *Server) webhookCondition(trigger map[string]interface{}, condition map[string]interface{}, details map[string]interface{}) (map[string]interface{}, error) {
+	source := stringArg(details, "webhookSourceType")
+	eventField, ok := webhookEvents[source]
+	if !ok {
+		return nil, invalid("webhookSourceType", fmt.Sprintf("Invalid request: unsupported webhook source %s", source))
+	}
+
+	var event map[string]interface{}
+	switch e := details[eventField].(type) {
+	case map[string]interface{}:
+		event = map[string]interface{}{"event": e["event"], "action": e["action"]}
+	case string:
+		event = map[string]interface{}{"event": e, "action": nil}
+	}
+	if eventField != "" && event == nil {
+		return nil, invalid(eventField, fmt.Sprintf("Invalid request: %s must be provided for %s webhooks", eventField, source))
+	}
+
+	token := s.newID()
+	if previous, ok := trigger["condition"].(map[string]interface{}); ok && previous["webhookToken"] != nil {
+		token = previous["webhookToken"].(string)
+	}
+
+	condition["webhookSource"] = source
+	condition["webhookEvent"] = event
+	condition["branchRegex"] = details["branchRegex"]
+	condition["webhookToken"] = token
+	condition["webhookDetails"] = map[string]interface{}{
+		"webhookURL": fmt.Sprintf("%s/api/webhooks/%s", s.URL, token),
+		"method":     "POST",
+		"header":     "content-type: application/json",
+		"payload":    nil,
+	}
+
+	return condition, nil
+}
+
+// triggerAction validates the action of a trigger and returns it as stored.
+// Workflows and pipelines are managed as YAML, so any id is accepted for
+// them.
+func (s *Server) triggerAction(appID interface{}, conditionType string, input map[string]interface{}) (map[string]interface{}, error) {
+	actionInput, _ := input["action"].(map[string]interface{})
+	entityID := stringArg(actionInput, "entityId")
+	if entityID == "" {
+		return nil, invalid("entityId", "Invalid request: entityId cannot be empty")
+	}
+
+	action := map[string]interface{}{
+		"artifactSelections": []interface{}{},
+		"variables":          []interface{}{},
+	}
+	switch stringArg(actionInput, "executionType") {
+	case "WORKFLOW":
+		action["__typename"], action["workflowId"] = "WorkflowAction", entityID
+	case "PIPELINE":
+		action["__typename"], action["pipelineId"] = "PipelineAction", entityID
+	default:
+		return nil, invalid("executionType", fmt.Sprintf("Invalid request: unsupported execution type %v", actionInput["executionType"]))
+	}
+
+	selections, _ := actionInput["artifactSelections"].([]interface{})
+	for _, sel := range selections {
+		selection, _ := sel.(map[string]interface{})
+		stored, err := s.artifactSelection(appID, conditionType, selection)
+		if err != nil {
+			return nil, err
+		}
+		action["artifactSelections"] = append(action["artifactSelections"].([]interface{}), stored)
+	}
+
+	if variables, ok := actionInput["variables"].([]interface{}); ok {
+		action["variables"] = variables
+	}
+
+	return action, nil
+}
+
+func (s *Server) artifactSelection(appID interface{}, conditionType string, selection map[string]interface{}) (map[string]interface{}, error) {
+	serviceID := stringArg(selection, "serviceId")
+	if svc, ok := s.get("service", serviceID); !ok || svc["applicationId"] != appID {
+		return nil, invalid("artifactSelections", fmt.Sprintf("Invalid request: service %s does not exist in the application", serviceID))
+	}
+
+	selectionType := stringArg(selection, "artifactSelectionType")
+	kind, ok := artifactSelections[selectionType]
+	if !ok {
+		return nil, invalid("artifactSelectionType", fmt.Sprintf("Invalid request: unsupported artifact selection type %s", selectionType))
+	}
+	if kind.condition != "" && kind.condition != conditionType {
+		return nil, invalid("artifactSelectionType", fmt.Sprintf("Invalid request: artifact selection type %s cannot be used with condition type %s", selectionType, conditionType))
+	}
+	if kind.required != "" && stringArg(selection, kind.required) == "" {
+		return nil, invalid(kind.required, fmt.Sprintf("Invalid request: %s must be provided for artifact selection type %s", kind.required, selectionType))
+	}
+
+	stored := map[string]interface{}{"__typename": kind.typeName}
+	merge(stored, selection, "artifactSelectionType")
+	return stored, nil
+}
+
+// webhookPayload returns the body to post to a custom webhook to execute
+// the trigger, with a placeholder for each value it expects.
+func (s *Server) webhookPayload(trigger map[string]interface{}) string {
+	action := trigger["action"].(map[string]interface{})
+
+	var artifacts []map[string]interface{}
+	for _, sel := range action["artifactSelections"].([]interface{}) {
+		selection := sel.(map[string]interface{})
+		if selection["__typename"] != "FromWebhookPayload" {
+			continue
+		}
+		svc, _ := s.get("service", stringArg(selection, "serviceId"))
+		artifacts = append(artifacts, map[string]interface{}{
+			"service":     svc["name"],
+			"buildNumber": fmt.Sprintf("%v_BUILD_NUMBER_PLACE_HOLDER", svc["name"]),
+		})
+	}
+
+	parameters := map[string]interface{}{}
+	for _, v := range action["variables"].([]interface{}) {
+		name := stringArg(v.(map[string]interface{}), "name")
+		parameters[name] = name + "_placeholder"
+	}
+
+	payload, _ := json.Marshal(map[string]interface{}{
+		"application": trigger["applicationId"],
+		"artifacts":   artifacts,
+		"parameters":  parameters,
+	})
+	return string(payload)
+}
+
+func (s *Server) storeTrigger(trigger map[string]interface{}, input map[string]interface{}) error {
+	appID := trigger["applicationId"]
+
+	condition, err := s.triggerCondition(trigger, input)
+	if err != nil {
+		return err
+	}
+
+	conditionInput := input["condition"].(map[string]interface{})
+	action, err := s.triggerAction(appID, stringArg(conditionInput, "conditionType"), input)
+	if err != nil {
+		return err
+	}
+
+	trigger["name"] = input["name"]
+	trigger["description"] = input["description"]
+	trigger["condition"] = condition
+	trigger["action"] = action
+	trigger["excludeHostsWithSameArtifact"] = false
+	if actionInput, ok := input["action"].(map[string]interface{}); ok && actionInput["excludeHostsWithSameArtifact"] != nil {
+		trigger["excludeHostsWithSameArtifact"] = actionInput["excludeHostsWithSameArtifact"]
+	}
+
+	if condition["webhookSource"] == "CUSTOM" {
+		condition["webhookDetails"].(map[string]interface{})["payload"] = s.webhookPayload(trigger)
+	}
+
+	s.put("trigger", trigger)
+	return nil
+}
+
+func (s *Server) createTrigger(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	appID, err := s.lookupApplication(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameIn("trigger", "Trigger", scope{"applicationId": appID}, "", input); err != nil {
+		return nil, err
+	}
+
+	trigger := map[string]interface{}{
+		"id":            s.newID(),
+		"applicationId": appID,
+	}
+	if err := s.storeTrigger(trigger, input); err != nil {
+		return nil, err
+	}
+
+	return payload(input, "trigger", trigger), nil
+}
+
+func (s *Server) updateTrigger(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	trigger, err := s.lookupAppEntity("trigger", input, "triggerId", triggerNotFound)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNameIn("trigger", "Trigger", scope{"applicationId": trigger["applicationId"]}, trigger["id"].(string), input); err != nil {
+		return nil, err
+	}
+
+	// Validate against a copy so that a rejected update leaves the trigger
+	// untouched.
+	updated := copyMap(trigger)
+	if err := s.storeTrigger(updated, input); err != nil {
+		return nil, err
+	}
+
+	return payload(input, "trigger", updated), nil
+}
+
+func (s *Server) deleteTrigger(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	trigger, err := s.lookupAppEntity("trigger", input, "triggerId", triggerNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	s.remove("trigger", trigger["id"].(string))
+
+	return payload(input, "", nil), nil
+}
@@ -0,0 +1,246 @@
+package harnesstest
+
+import "fmt"
+
+// appPermissionActions are the actions each type of application permission
+// can grant.
+var appPermissionActions = map[string][]string{
+	"ALL":         {"CREATE", "READ", "UPDATE", "DELETE", "EXECUTE_WORKFLOW", "EXECUTE_PIPELINE", "ROLLBACK_WORKFLOW"},
+	"SERVICE":     {"CREATE", "READ", "UPDATE", "DELETE"},
+	"ENV":         {"CREATE", "READ", "UPDATE", "DELETE"},
+	"WORKFLOW":    {"CREATE", "READ", "UPDATE", "DELETE"},
+	"PIPELINE":    {"CREATE", "READ", "UPDATE", "DELETE"},
+	"PROVISIONER": {"CREATE", "READ", "UPDATE", "DELETE"},
+	"DEPLOYMENT":  {"READ", "EXECUTE_WORKFLOW", "EXECUTE_PIPELINE", "ROLLBACK_WORKFLOW"},
+}
+
+func (s *Server) registerUserGroups() {
+	s.queries["userGroup"] = s.userGroup
+	s.queries["userGroupByName"] = s.userGroupByName
+	s.mutations["createUserGroup"] = s.createUserGroup
+	s.mutations["updateUserGroup"] = s.updateUserGroup
+	s.mutations["deleteUserGroup"] = s.deleteUserGroup
+}
+
+func userGroupNotFound() error {
+	return notFound("User group does not exist")
+}
+
+func (s *Server) userGroup(args map[string]interface{}) (interface{}, error) {
+	group, ok := s.get("userGroup", stringArg(args, "userGroupId"))
+	if !ok {
+		return nil, userGroupNotFound()
+	}
+	return group, nil
+}
+
+func (s *Server) userGroupByName(args map[string]interface{}) (interface{}, error) {
+	group, ok := s.findByName("userGroup", nil, stringArg(args, "name"))
+	if !ok {
+		return nil, userGroupNotFound()
+	}
+	return group, nil
+}
+
+// userGroupPermissions validates the permissions of a user group and returns
+// them as stored.
+func (s *Server) userGroupPermissions(input map[string]interface{}) (map[string]interface{}, error) {
+	permissions := map[string]interface{}{
+		"accountPermissions": map[string]interface{}{"accountPermissionTypes": []interface{}{}},
+		"appPermissions":     []interface{}{},
+	}
+
+	permissionsInput, _ := input["permissions"].(map[string]interface{})
+	if accountInput, ok := permissionsInput["accountPermissions"].(map[string]interface{}); ok {
+		if types, ok := accountInput["accountPermissionTypes"].([]interface{}); ok {
+			permissions["accountPermissions"] = map[string]interface{}{"accountPermissionTypes": types}
+		}
+	}
+
+	appPermissions, _ := permissionsInput["appPermissions"].([]interface{})
+	for _, p := range appPermissions {
+		permission, _ := p.(map[string]interface{})
+		stored, err := s.appPermission(permission)
+		if err != nil {
+			return nil, err
+		}
+		permissions["appPermissions"] = append(permissions["appPermissions"].([]interface{}), stored)
+	}
+
+	return permissions, nil
+}
+
+func (s *Server) appPermission(permission map[string]interface{}) (map[string]interface{}, error) {
+	permissionType := stringArg(permission, "permissionType")
+	allowed, ok := appPermissionActions[permissionType]
+	if !ok {
+		return nil, invalid("permissionType", fmt.Sprintf("Invalid request: unsupported permission type %s", permissionType))
+	}
+
+	applications, _ := permission["applications"].(map[string]interface{})
+	appIDs, _ := applications["appIds"].([]interface{})
+	filterType := stringArg(applications, "filterType")
+	if (filterType == "") == (len(appIDs) == 0) {
+		return nil, invalid("applications", "Invalid request: applications must either have filterType ALL or list appIds")
+	}
+	for _, id := range appIDs {
+		if _, ok := s.get("application", fmt.Sprint(id)); !ok {
+			return nil, invalid("appIds", fmt.Sprintf("Invalid request: application %v does not exist", id))
+		}
+	}
+
+	actions, _ := permission["actions"].([]interface{})
+	if len(actions) == 0 {
+		return nil, invalid("actions", "Invalid request: actions cannot be empty")
+	}
+	for _, action := range actions {
+		if !contains(allowed, fmt.Sprint(action)) {
+			return nil, invalid("actions", fmt.Sprintf("Invalid request: action %v cannot be granted on %s", action, permissionType))
+		}
+	}
+
+	var filterTypeValue interface{}
+	if filterType != "" {
+		filterTypeValue = filterType
+	}
+
+	return map[string]interface{}{
+		"permissionType": permissionType,
+		"applications": map[string]interface{}{
+			"filterType": filterTypeValue,
+			"appIds":     appIDs,
+		},
+		"actions": actions,
+	}, nil
+}
+
+// notificationSettings validates the notification settings of a user group
+// and returns them as stored.
+func (s *Server) notificationSettings(input map[string]interface{}) (map[string]interface{}, error) {
+	settings := map[string]interface{}{
+		"sendNotificationToMembers":       false,
+		"sendMailToNewMembers":            false,
+		"groupEmailAddresses":             []interface{}{},
+		"slackNotificationSetting":        map[string]interface{}{"slackChannelName": nil, "slackWebhookURL": nil},
+		"pagerDutyIntegrationKeySecretId": nil,
+	}
+
+	settingsInput, _ := input["notificationSettings"].(map[string]interface{})
+	if settingsInput == nil {
+		return settings, nil
+	}
+
+	if secretID := stringArg(settingsInput, "pagerDutyIntegrationKeySecretId"); secretID != "" {
+		if _, err := s.lookupSecret(secretID, "ENCRYPTED_TEXT"); err != nil {
+			return nil, invalid("pagerDutyIntegrationKeySecretId", fmt.Sprintf("Invalid request: secret %s does not exist", secretID))
+		}
+	}
+
+	slack := settings["slackNotificationSetting"].(map[string]interface{})
+	if slackInput, ok := settingsInput["slackNotificationSetting"].(map[string]interface{}); ok {
+		merge(slack, slackInput)
+	}
+
+	merge(settings, settingsInput, "slackNotificationSetting")
+	return settings, nil
+}
+
+func (s *Server) storeUserGroup(group map[string]interface{}, input map[string]interface{}) error {
+	if input["permissions"] != nil {
+		permissions, err := s.userGroupPermissions(input)
+		if err != nil {
+			return err
+		}
+		group["permissions"] = permissions
+	}
+
+	if input["notificationSettings"] != nil {
+		settings, err := s.notificationSettings(input)
+		if err != nil {
+			return err
+		}
+		group["notificationSettings"] = settings
+	}
+
+	merge(group, input, "clientMutationId", "userGroupId", "permissions", "notificationSettings")
+	s.put("userGroup", group)
+	return nil
+}
+
+func (s *Server) createUserGroup(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	if err := s.checkName("userGroup", "User group", "", input); err != nil {
+		return nil, err
+	}
+
+	permissions, _ := s.userGroupPermissions(nil)
+	settings, _ := s.notificationSettings(nil)
+	group := map[string]interface{}{
+		"id":                   s.newID(),
+		"description":          nil,
+		"permissions":          permissions,
+		"notificationSettings": settings,
+	}
+	if err := s.storeUserGroup(group, input); err != nil {
+		return nil, err
+	}
+
+	return payload(input, "userGroup", group), nil
+}
+
+func (s *Server) updateUserGroup(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "userGroupId")
+
+	group, ok := s.get("userGroup", id)
+	if !ok {
+		return nil, userGroupNotFound()
+	}
+	if err := s.checkName("userGroup", "User group", id, input); err != nil {
+		return nil, err
+	}
+
+	// Validate against a copy so that a rejected update leaves the group
+	// untouched.
+	updated := copyMap(group)
+	if err := s.storeUserGroup(updated, input); err != nil {
+		return nil, err
+	}
+
+	return payload(input, "userGroup", updated), nil
+}
+
+func (s *Server) deleteUserGroup(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	id := stringArg(input, "userGroupId")
+	if !s.remove("userGroup", id) {
+		return nil, userGroupNotFound()
+	}
+	for _, user := range s.entities["user"] {
+		s.removeFromUserGroup(user, id)
+	}
+
+	return payload(input, "", nil), nil
+}
+
+// removeAppFromUserGroups drops a deleted application from the permissions
+// of every user group, as Harness.io does.
+func (s *Server) removeAppFromUserGroups(appID string) {
+	for _, group := range s.entities["userGroup"] {
+		permissions := group["permissions"].(map[string]interface{})
+		for _, p := range permissions["appPermissions"].([]interface{}) {
+			applications := p.(map[string]interface{})["applications"].(map[string]interface{})
+			appIDs, _ := applications["appIds"].([]interface{})
+
+			kept := []interface{}{}
+			for _, id := range appIDs {
+				if id != appID {
+					kept = append(kept, id)
+				}
+			}
+			if appIDs != nil {
+				applications["appIds"] = kept
+			}
+		}
+	}
+}
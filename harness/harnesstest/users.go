@@ -0,0 +1,187 @@
+package harnesstest
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (s *Server) registerUsers() {
+	s.queries["user"] = s.user
+	s.queries["userByEmail"] = s.userByEmail
+	s.queries["users"] = s.users
+	s.mutations["createUser"] = s.createUser
+	s.mutations["updateUser"] = s.updateUser
+	s.mutations["deleteUser"] = s.deleteUser
+	s.mutations["addUserToGroup"] = s.addUserToGroup
+	s.mutations["removeUserFromGroup"] = s.removeUserFromGroup
+}
+
+// AcceptInvitation accepts the invitation of the user with the given email,
+// as the user would by following the link Harness.io mails them.
+func (s *Server) AcceptInvitation(email string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.findUserByEmail(email)
+	if !ok {
+		return false
+	}
+	user["invitationStatus"] = "ACCEPTED"
+	return true
+}
+
+func userNotFound() error {
+	return notFound("User does not exist")
+}
+
+func (s *Server) findUserByEmail(email string) (map[string]interface{}, bool) {
+	for _, user := range s.entities["user"] {
+		if strings.EqualFold(user["email"].(string), email) {
+			return user, true
+		}
+	}
+	return nil, false
+}
+
+func (s *Server) user(args map[string]interface{}) (interface{}, error) {
+	user, ok := s.get("user", stringArg(args, "id"))
+	if !ok {
+		return nil, userNotFound()
+	}
+	return user, nil
+}
+
+func (s *Server) userByEmail(args map[string]interface{}) (interface{}, error) {
+	user, ok := s.findUserByEmail(stringArg(args, "email"))
+	if !ok {
+		return nil, userNotFound()
+	}
+	return user, nil
+}
+
+func (s *Server) users(args map[string]interface{}) (interface{}, error) {
+	return s.connection("user", args, filterFields{
+		"user":      "id",
+		"userGroup": "userGroupIds",
+	})
+}
+
+// checkUserGroups makes sure the user groups a user is put in exist.
+func (s *Server) checkUserGroups(input map[string]interface{}) error {
+	ids, _ := input["userGroupIds"].([]interface{})
+	for _, id := range ids {
+		if _, ok := s.get("userGroup", fmt.Sprint(id)); !ok {
+			return invalid("userGroupIds", fmt.Sprintf("Invalid request: user group %v does not exist", id))
+		}
+	}
+	return nil
+}
+
+func (s *Server) createUser(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	email := strings.TrimSpace(stringArg(input, "email"))
+	if !strings.Contains(email, "@") {
+		return nil, invalid("email", fmt.Sprintf("Invalid request: %q is not a valid email address", email))
+	}
+	if _, ok := s.findUserByEmail(email); ok {
+		return nil, &graphQLError{Message: fmt.Sprintf("User with the email '%s' already exists", email)}
+	}
+	if strings.TrimSpace(stringArg(input, "name")) == "" {
+		return nil, invalid("name", "Invalid request: name cannot be empty")
+	}
+	if err := s.checkUserGroups(input); err != nil {
+		return nil, err
+	}
+
+	user := map[string]interface{}{
+		"id":               s.newID(),
+		"invitationStatus": "PENDING",
+		"userGroupIds":     []interface{}{},
+	}
+	merge(user, input, "clientMutationId")
+	s.put("user", user)
+
+	return payload(input, "user", user), nil
+}
+
+func (s *Server) updateUser(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	user, ok := s.get("user", stringArg(input, "id"))
+	if !ok {
+		return nil, userNotFound()
+	}
+	if name, ok := input["name"].(string); ok && strings.TrimSpace(name) == "" {
+		return nil, invalid("name", "Invalid request: name cannot be empty")
+	}
+	if err := s.checkUserGroups(input); err != nil {
+		return nil, err
+	}
+
+	merge(user, input, "clientMutationId", "id", "email")
+
+	return payload(input, "user", user), nil
+}
+
+func (s *Server) deleteUser(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	if !s.remove("user", stringArg(input, "id")) {
+		return nil, userNotFound()
+	}
+
+	return payload(input, "", nil), nil
+}
+
+// lookupMembership returns the user and user group a membership mutation
+// refers to.
+func (s *Server) lookupMembership(input map[string]interface{}) (map[string]interface{}, map[string]interface{}, error) {
+	user, ok := s.get("user", stringArg(input, "userId"))
+	if !ok {
+		return nil, nil, userNotFound()
+	}
+	group, ok := s.get("userGroup", stringArg(input, "userGroupId"))
+	if !ok {
+		return nil, nil, userGroupNotFound()
+	}
+	return user, group, nil
+}
+
+func (s *Server) addUserToGroup(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	user, group, err := s.lookupMembership(input)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, _ := user["userGroupIds"].([]interface{})
+	for _, id := range ids {
+		if id == group["id"] {
+			return payload(input, "userGroup", group), nil
+		}
+	}
+	user["userGroupIds"] = append(append([]interface{}{}, ids...), group["id"])
+
+	return payload(input, "userGroup", group), nil
+}
+
+func (s *Server) removeUserFromGroup(args map[string]interface{}) (interface{}, error) {
+	input := inputArg(args)
+	user, group, err := s.lookupMembership(input)
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeFromUserGroup(user, group["id"].(string))
+
+	return payload(input, "userGroup", group), nil
+}
+
+func (s *Server) removeFromUserGroup(user map[string]interface{}, groupID string) {
+	ids, _ := user["userGroupIds"].([]interface{})
+	kept := []interface{}{}
+	for _, id := range ids {
+		if id != groupID {
+			kept = append(kept, id)
+		}
+	}
+	user["userGroupIds"] = kept
+}
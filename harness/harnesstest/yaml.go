@@ -0,0 +1,107 @@
+package harnesstest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// yamlPathPrefix is where the config-as-code endpoints live.
+const yamlPathPrefix = "/setup-as-code/yaml/"
+
+type restResponse struct {
+	Resource         interface{}        `json:"resource"`
+	ResponseMessages []*responseMessage `json:"responseMessages"`
+}
+
+type responseMessage struct {
+	Code    string `json:"code"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+func restError(w http.ResponseWriter, statusCode int, code string, message string) {
+	writeJSON(w, statusCode, &restResponse{ResponseMessages: []*responseMessage{{
+		Code:    code,
+		Level:   "ERROR",
+		Message: message,
+	}}})
+}
+
+// YAML returns the config-as-code YAML stored at path.
+func (s *Server) YAML(path string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, ok := s.yaml[path]
+	return content, ok
+}
+
+// serveYAML answers the config-as-code endpoints. Like Harness.io, it returns
+// YAML re-rendered from what it parsed, so keys may come back in another
+// order than they were sent in.
+func (s *Server) serveYAML(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	endpoint := r.URL.Path[strings.Index(r.URL.Path, yamlPathPrefix)+len(yamlPathPrefix):]
+	switch {
+	case endpoint == "upsert-entity" && r.Method == http.MethodPost:
+		s.upsertYAML(w, r)
+	case endpoint == "yaml-content" && r.Method == http.MethodGet:
+		s.getYAML(w, r)
+	case endpoint == "delete-entities" && r.Method == http.MethodDelete:
+		s.deleteYAML(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) upsertYAML(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Query().Get("yamlFilePath")
+	if !strings.HasPrefix(path, "Setup/") || !strings.HasSuffix(path, ".yaml") {
+		restError(w, http.StatusBadRequest, "INVALID_REQUEST", fmt.Sprintf("Invalid request: %s is not a YAML file path", path))
+		return
+	}
+
+	status := map[string]interface{}{"yamlFilePath": path, "status": "SUCCESS"}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(r.FormValue("yamlContent")), &doc); err != nil {
+		status["status"], status["errorMssg"] = "FAILED", "Invalid YAML: "+err.Error()
+	} else if doc["type"] == nil {
+		status["status"], status["errorMssg"] = "FAILED", "Invalid YAML: type is required"
+	} else {
+		content, _ := yaml.Marshal(doc)
+		s.yaml[path] = string(content)
+	}
+
+	writeJSON(w, http.StatusOK, &restResponse{Resource: status})
+}
+
+func (s *Server) getYAML(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Query().Get("yamlFilePath")
+	content, ok := s.yaml[path]
+	if !ok {
+		restError(w, http.StatusBadRequest, "RESOURCE_NOT_FOUND", fmt.Sprintf("No YAML exists at %s", path))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &restResponse{Resource: map[string]interface{}{
+		"yamlFilePath": path,
+		"yaml":         content,
+	}})
+}
+
+func (s *Server) deleteYAML(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Query().Get("filePaths")
+	if _, ok := s.yaml[path]; !ok {
+		restError(w, http.StatusBadRequest, "RESOURCE_NOT_FOUND", fmt.Sprintf("No YAML exists at %s", path))
+		return
+	}
+
+	delete(s.yaml, path)
+	writeJSON(w, http.StatusOK, &restResponse{})
+}
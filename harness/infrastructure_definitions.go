@@ -0,0 +1,126 @@
+package harness
+
+import "context"
+
+// GetInfrastructureDefinition fetches an infrastructure definition of an
+// application by its id.
+func (h *Client) GetInfrastructureDefinition(ctx context.Context, appID string, id string) (*InfrastructureDefinition, error) {
+	h.logger.Debugf("Getting a Harness.io infrastructure definition with id '%s' in application '%s'", id, appID)
+
+	infra, err := h.infrastructureDefinition(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if infra == nil || infra.ApplicationID != appID {
+		return nil, newNotFoundError("infrastructure definition")
+	}
+
+	return infra, nil
+}
+
+func (h *Client) NewInfrastructureDefinition(ctx context.Context, i *InfrastructureDefinition) (*InfrastructureDefinition, error) {
+	h.logger.Debugf("Creating a Harness.io infrastructure definition with name '%s' in environment '%s'", i.Name, i.EnvironmentID)
+
+	kubernetesDirect, azureKubernetes := infrastructureDetailsInputs(i)
+	payload, err := h.createInfrastructureDefinition(ctx, &CreateInfrastructureDefinitionInput{
+		ApplicationID:      i.ApplicationID,
+		EnvironmentID:      i.EnvironmentID,
+		Name:               i.Name,
+		DeploymentType:     i.DeploymentType,
+		ScopedServices:     scopedServices(i.ScopedServices),
+		InfrastructureType: i.InfrastructureType,
+		KubernetesDirect:   kubernetesDirect,
+		AzureKubernetes:    azureKubernetes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.InfrastructureDefinition == nil {
+		return nil, newNotFoundError("infrastructure definition")
+	}
+
+	return payload.InfrastructureDefinition, nil
+}
+
+// UpdateInfrastructureDefinition updates the name, scoped services and
+// details of an infrastructure definition. Its environment and deployment
+// type cannot be changed.
+func (h *Client) UpdateInfrastructureDefinition(ctx context.Context, i *InfrastructureDefinition) (*InfrastructureDefinition, error) {
+	h.logger.Debugf("Updating a Harness.io infrastructure definition with id '%s' in application '%s'", i.ID, i.ApplicationID)
+
+	kubernetesDirect, azureKubernetes := infrastructureDetailsInputs(i)
+	payload, err := h.updateInfrastructureDefinition(ctx, &UpdateInfrastructureDefinitionInput{
+		ApplicationID:              i.ApplicationID,
+		InfrastructureDefinitionID: i.ID,
+		Name:                       String(i.Name),
+		ScopedServices:             scopedServices(i.ScopedServices),
+		InfrastructureType:         i.InfrastructureType,
+		KubernetesDirect:           kubernetesDirect,
+		AzureKubernetes:            azureKubernetes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.InfrastructureDefinition == nil {
+		return nil, newNotFoundError("infrastructure definition")
+	}
+
+	return payload.InfrastructureDefinition, nil
+}
+
+func (h *Client) DeleteInfrastructureDefinition(ctx context.Context, appID string, id string) error {
+	h.logger.Debugf("Deleting a Harness.io infrastructure definition with id '%s' in application '%s'", id, appID)
+
+	_, err := h.deleteInfrastructureDefinition(ctx, &DeleteInfrastructureDefinitionInput{
+		ApplicationID:              appID,
+		InfrastructureDefinitionID: id,
+	})
+
+	return err
+}
+
+// scopedServices always returns a list, so that an infrastructure definition
+// no longer scoped to any service is made available to all of them.
+func scopedServices(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
+
+// infrastructureDetailsInputs converts the details of an infrastructure
+// definition into the input of its infrastructure type.
+func infrastructureDetailsInputs(i *InfrastructureDefinition) (*KubernetesDirectInfrastructureInput, *AzureKubernetesInfrastructureInput) {
+	details := i.Details
+	if details == nil {
+		return nil, nil
+	}
+
+	var releaseName *string
+	if details.ReleaseName != "" {
+		releaseName = String(details.ReleaseName)
+	}
+
+	switch i.InfrastructureType {
+	case InfrastructureTypeKubernetesDirect:
+		return &KubernetesDirectInfrastructureInput{
+			CloudProviderID: details.CloudProviderID,
+			Namespace:       details.Namespace,
+			ReleaseName:     releaseName,
+		}, nil
+	case InfrastructureTypeAzureKubernetes:
+		return nil, &AzureKubernetesInfrastructureInput{
+			CloudProviderID: details.CloudProviderID,
+			SubscriptionID:  details.SubscriptionID,
+			ResourceGroup:   details.ResourceGroup,
+			ClusterName:     details.ClusterName,
+			Namespace:       details.Namespace,
+			ReleaseName:     releaseName,
+		}
+	}
+
+	return nil, nil
+}
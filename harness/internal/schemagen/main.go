@@ -0,0 +1,554 @@
+// Command schemagen generates the types and operations of the harness package
+// from an introspection dump of the Harness.io GraphQL schema.
+//
+// The operations to generate are listed in a JSON config file. Only the types
+// reachable from those operations are emitted, so the dump can be the full
+// schema. Each operation selects every field of its result, following nested
+// objects and the implementations of interfaces.
+//
+// Nullable input lists are left out of requests when empty, like any other
+// optional input. The fields listed under clearedLists, as Type.field, are
+// always sent instead, so that an empty list clears them.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type config struct {
+	Queries      []string `json:"queries"`
+	Mutations    []string `json:"mutations"`
+	ClearedLists []string `json:"clearedLists"`
+}
+
+func main() {
+	schemaPath := flag.String("schema", "schema/schema.json", "introspection dump of the schema")
+	configPath := flag.String("config", "schema/generate.json", "operations to generate")
+	outPath := flag.String("out", "schema_gen.go", "file to write")
+	pkg := flag.String("package", "harness", "package of the generated file")
+	flag.Parse()
+
+	s, err := loadSchema(*schemaPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	cfg := &config{}
+	if err := readJSON(*configPath, cfg); err != nil {
+		log.Fatal(err)
+	}
+
+	g := &generator{schema: s, emitted: map[string]bool{}, cleared: map[string]bool{}}
+	src, err := g.generate(*pkg, *schemaPath, cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*outPath, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func readJSON(path string, v interface{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewDecoder(f).Decode(v)
+}
+
+type schema struct {
+	QueryType    *typeRef    `json:"queryType"`
+	MutationType *typeRef    `json:"mutationType"`
+	Types        []*fullType `json:"types"`
+
+	byName map[string]*fullType
+}
+
+type fullType struct {
+	Kind          string       `json:"kind"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+	Fields        []*fieldDef  `json:"fields"`
+	InputFields   []*inputDef  `json:"inputFields"`
+	EnumValues    []*enumValue `json:"enumValues"`
+	PossibleTypes []*typeRef   `json:"possibleTypes"`
+}
+
+type fieldDef struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Args        []*inputDef `json:"args"`
+	Type        *typeRef    `json:"type"`
+}
+
+type inputDef struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Type        *typeRef `json:"type"`
+}
+
+type enumValue struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type typeRef struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *typeRef `json:"ofType"`
+}
+
+// named returns the named type at the bottom of a list or non-null wrapper.
+func (t *typeRef) named() *typeRef {
+	for t.OfType != nil {
+		t = t.OfType
+	}
+	return t
+}
+
+// String renders the reference in GraphQL notation, such as [String!]!.
+func (t *typeRef) String() string {
+	switch t.Kind {
+	case "NON_NULL":
+		return t.OfType.String() + "!"
+	case "LIST":
+		return "[" + t.OfType.String() + "]"
+	}
+	return t.Name
+}
+
+func loadSchema(path string) (*schema, error) {
+	dump := &struct {
+		Data struct {
+			Schema *schema `json:"__schema"`
+		} `json:"data"`
+	}{}
+	if err := readJSON(path, dump); err != nil {
+		return nil, err
+	}
+
+	s := dump.Data.Schema
+	if s == nil {
+		return nil, fmt.Errorf("%s is not an introspection result", path)
+	}
+
+	s.byName = map[string]*fullType{}
+	for _, t := range s.Types {
+		s.byName[t.Name] = t
+	}
+
+	return s, nil
+}
+
+func (s *schema) rootField(root *typeRef, name string) (*fieldDef, error) {
+	if root == nil {
+		return nil, fmt.Errorf("schema has no root type for %s", name)
+	}
+
+	for _, f := range s.byName[root.Name].Fields {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s has no field %s", root.Name, name)
+}
+
+type generator struct {
+	schema  *schema
+	emitted map[string]bool
+	types   []*fullType
+	// cleared holds the input lists always sent, as Type.field.
+	cleared map[string]bool
+}
+
+func (g *generator) generate(pkg string, schemaPath string, cfg *config) ([]byte, error) {
+	var ops bytes.Buffer
+
+	for _, name := range cfg.Queries {
+		f, err := g.schema.rootField(g.schema.QueryType, name)
+		if err != nil {
+			return nil, err
+		}
+		g.operation(&ops, "query", f)
+	}
+
+	for _, name := range cfg.Mutations {
+		f, err := g.schema.rootField(g.schema.MutationType, name)
+		if err != nil {
+			return nil, err
+		}
+		g.operation(&ops, "mutation", f)
+	}
+
+	for _, name := range cfg.ClearedLists {
+		if err := g.checkClearedList(name); err != nil {
+			return nil, err
+		}
+		g.cleared[name] = true
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by schemagen from %s. DO NOT EDIT.\n\n", schemaPath)
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	fmt.Fprintf(&out, "import \"context\"\n\n")
+
+	sort.Slice(g.types, func(i, j int) bool { return g.types[i].Name < g.types[j].Name })
+	for _, kind := range []string{"ENUM", "INPUT_OBJECT", "INTERFACE", "OBJECT"} {
+		for _, t := range g.types {
+			if t.Kind == kind {
+				g.typeDecl(&out, t)
+			}
+		}
+	}
+
+	out.Write(ops.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, out.Bytes())
+	}
+	return src, nil
+}
+
+// checkClearedList makes sure name, of the form Type.field, is a nullable
+// list field of an input that is generated.
+func (g *generator) checkClearedList(name string) error {
+	i := strings.Index(name, ".")
+	if i < 0 {
+		return fmt.Errorf("cleared list %s is not of the form Type.field", name)
+	}
+
+	t := g.schema.byName[name[:i]]
+	if t == nil || t.Kind != "INPUT_OBJECT" || !g.emitted[t.Name] {
+		return fmt.Errorf("cleared list %s is not a field of a generated input", name)
+	}
+
+	for _, f := range t.InputFields {
+		if f.Name == name[i+1:] {
+			if f.Type.Kind != "LIST" {
+				return fmt.Errorf("cleared list %s is a %s, not a nullable list", name, f.Type)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cleared list %s is not a field of %s", name, t.Name)
+}
+
+// use records that a type, and every type it refers to, must be emitted.
+func (g *generator) use(ref *typeRef) {
+	name := ref.named().Name
+	t := g.schema.byName[name]
+	if t == nil || g.emitted[name] || t.Kind == "SCALAR" {
+		return
+	}
+
+	g.emitted[name] = true
+	g.types = append(g.types, t)
+
+	for _, f := range t.Fields {
+		g.use(f.Type)
+	}
+	for _, f := range t.InputFields {
+		g.use(f.Type)
+	}
+	for _, p := range t.PossibleTypes {
+		g.use(p)
+	}
+}
+
+func (g *generator) typeDecl(out *bytes.Buffer, t *fullType) {
+	switch t.Kind {
+	case "ENUM":
+		comment(out, "", t.Description, fmt.Sprintf("%s is the %s enum of the Harness.io schema.", t.Name, t.Name))
+		fmt.Fprintf(out, "type %s string\n\nconst (\n", t.Name)
+		for _, v := range t.EnumValues {
+			comment(out, "\t", v.Description, "")
+			fmt.Fprintf(out, "\t%s%s %s = %q\n", t.Name, goName(strings.ToLower(v.Name)), t.Name, v.Name)
+		}
+		fmt.Fprintf(out, ")\n\n")
+
+	case "INPUT_OBJECT":
+		comment(out, "", t.Description, fmt.Sprintf("%s is the %s input of the Harness.io schema.", t.Name, t.Name))
+		fmt.Fprintf(out, "type %s struct {\n", t.Name)
+		for _, f := range t.InputFields {
+			comment(out, "\t", f.Description, "")
+			goType, optional := g.inputType(f.Type)
+			tag := f.Name
+			if optional && !g.cleared[t.Name+"."+f.Name] {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(out, "\t%s %s `json:%q`\n", goName(f.Name), goType, tag)
+		}
+		fmt.Fprintf(out, "}\n\n")
+
+	case "INTERFACE":
+		comment(out, "", t.Description, fmt.Sprintf("%s is the %s interface of the Harness.io schema.", t.Name, t.Name))
+		fmt.Fprintf(out, "// It holds the fields of every implementation, Typename tells which one was returned.\n")
+		fmt.Fprintf(out, "type %s struct {\n", t.Name)
+		fmt.Fprintf(out, "\tTypename string `json:\"__typename\"`\n")
+		for _, f := range g.interfaceFields(t) {
+			g.outputField(out, f)
+		}
+		fmt.Fprintf(out, "}\n\n")
+
+	case "OBJECT":
+		comment(out, "", t.Description, fmt.Sprintf("%s is the %s type of the Harness.io schema.", t.Name, t.Name))
+		fmt.Fprintf(out, "type %s struct {\n", t.Name)
+		for _, f := range t.Fields {
+			g.outputField(out, f)
+		}
+		fmt.Fprintf(out, "}\n\n")
+	}
+}
+
+func (g *generator) outputField(out *bytes.Buffer, f *fieldDef) {
+	comment(out, "\t", f.Description, "")
+	fmt.Fprintf(out, "\t%s %s `json:%q`\n", goName(f.Name), g.outputType(f.Type), f.Name)
+}
+
+// interfaceFields returns the fields of an interface followed by the fields
+// only some of its implementations have.
+func (g *generator) interfaceFields(t *fullType) []*fieldDef {
+	seen := map[string]bool{}
+	var fields []*fieldDef
+
+	add := func(f *fieldDef) {
+		if !seen[f.Name] {
+			seen[f.Name] = true
+			fields = append(fields, f)
+		}
+	}
+
+	for _, f := range t.Fields {
+		add(f)
+	}
+	for _, p := range t.PossibleTypes {
+		for _, f := range g.schema.byName[p.Name].Fields {
+			add(f)
+		}
+	}
+
+	return fields
+}
+
+func (g *generator) outputType(ref *typeRef) string {
+	switch ref.Kind {
+	case "NON_NULL":
+		return g.outputType(ref.OfType)
+	case "LIST":
+		return "[]" + g.outputType(ref.OfType)
+	case "SCALAR":
+		return scalarType(ref.Name)
+	case "ENUM":
+		return ref.Name
+	}
+	return "*" + ref.Name
+}
+
+// inputType returns the Go type of an input field and whether it may be left
+// out. Nullable scalars are pointers so that zero values can still be sent.
+func (g *generator) inputType(ref *typeRef) (string, bool) {
+	if ref.Kind == "NON_NULL" {
+		goType, _ := g.inputType(ref.OfType)
+		if strings.HasPrefix(goType, "*") && ref.OfType.Kind == "SCALAR" {
+			goType = goType[1:]
+		}
+		return goType, false
+	}
+
+	switch ref.Kind {
+	case "LIST":
+		elem, _ := g.inputType(ref.OfType)
+		if ref.OfType.Kind == "SCALAR" {
+			elem = strings.TrimPrefix(elem, "*")
+		}
+		return "[]" + elem, true
+	case "SCALAR":
+		return "*" + scalarType(ref.Name), true
+	case "ENUM":
+		return ref.Name, true
+	}
+	return "*" + ref.Name, true
+}
+
+func scalarType(name string) string {
+	switch name {
+	case "Boolean":
+		return "bool"
+	case "Int":
+		return "int"
+	case "Float":
+		return "float64"
+	}
+	return "string"
+}
+
+func (g *generator) operation(out *bytes.Buffer, kind string, f *fieldDef) {
+	g.use(f.Type)
+	for _, a := range f.Args {
+		g.use(a.Type)
+	}
+
+	var selection bytes.Buffer
+	g.selection(&selection, f.Type.named().Name, "    ", map[string]bool{})
+
+	resultType := g.outputType(f.Type)
+	params := make([]string, 0, len(f.Args))
+	values := make([]string, 0, len(f.Args))
+	for _, a := range f.Args {
+		goType, _ := g.inputType(a.Type)
+		params = append(params, fmt.Sprintf("%s %s", a.Name, goType))
+		values = append(values, fmt.Sprintf("%q: %s,", a.Name, a.Name))
+	}
+
+	fmt.Fprintf(out, "var %sOperation = &operation{\nkind: %q,\nname: %q,\n", f.Name, kind, f.Name)
+	if len(f.Args) > 0 {
+		fmt.Fprintf(out, "variables: []variable{\n")
+		for _, a := range f.Args {
+			fmt.Fprintf(out, "{name: %q, gqlType: %q},\n", a.Name, a.Type.String())
+		}
+		fmt.Fprintf(out, "},\n")
+	}
+	if selection.Len() > 0 {
+		fmt.Fprintf(out, "selection: `%s`,\n", strings.TrimPrefix(selection.String(), " "))
+	}
+	if kind == "mutation" && (strings.HasPrefix(f.Name, "create") || strings.HasPrefix(f.Name, "delete")) {
+		fmt.Fprintf(out, "nonIdempotent: true,\n")
+	}
+	fmt.Fprintf(out, "}\n\n")
+
+	fmt.Fprintf(out, "// %s runs the %s %s and returns every field of its result.\n", f.Name, f.Name, kind)
+	fmt.Fprintf(out, "func (h *Client) %s(ctx context.Context, %s) (%s, error) {\n", f.Name, strings.Join(params, ", "), resultType)
+	fmt.Fprintf(out, "response := &struct {\nData struct {\n%s %s `json:%q`\n} `json:\"data\"`\n}{}\n\n", goName(f.Name), resultType, f.Name)
+	fmt.Fprintf(out, "err := h.run(ctx, %sOperation, map[string]interface{}{\n%s\n}, response)\n", f.Name, strings.Join(values, "\n"))
+	fmt.Fprintf(out, "if err != nil {\nreturn nil, err\n}\n\n")
+	fmt.Fprintf(out, "return response.Data.%s, nil\n}\n\n", goName(f.Name))
+}
+
+// selection writes a selection set picking every field of the named type.
+// Types already being selected further up are skipped to break cycles.
+func (g *generator) selection(out *bytes.Buffer, typeName string, indent string, path map[string]bool) {
+	t := g.schema.byName[typeName]
+	if t == nil || (t.Kind != "OBJECT" && t.Kind != "INTERFACE") {
+		return
+	}
+
+	path[typeName] = true
+	defer delete(path, typeName)
+
+	fmt.Fprintf(out, " {")
+	if t.Kind == "INTERFACE" {
+		fmt.Fprintf(out, "\n%s__typename", indent)
+	}
+
+	common := map[string]bool{}
+	for _, f := range t.Fields {
+		common[f.Name] = true
+		g.selectField(out, f, indent, path)
+	}
+
+	for _, p := range t.PossibleTypes {
+		var specific []*fieldDef
+		for _, f := range g.schema.byName[p.Name].Fields {
+			if !common[f.Name] {
+				specific = append(specific, f)
+			}
+		}
+		if len(specific) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, "\n%s... on %s {", indent, p.Name)
+		for _, f := range specific {
+			g.selectField(out, f, indent+"  ", path)
+		}
+		fmt.Fprintf(out, "\n%s}", indent)
+	}
+
+	fmt.Fprintf(out, "\n%s}", indent[:len(indent)-2])
+}
+
+func (g *generator) selectField(out *bytes.Buffer, f *fieldDef, indent string, path map[string]bool) {
+	named := f.Type.named()
+	if len(f.Args) > 0 || path[named.Name] {
+		return
+	}
+
+	fmt.Fprintf(out, "\n%s%s", indent, f.Name)
+	g.selection(out, named.Name, indent+"  ", path)
+}
+
+// comment writes a doc comment starting with summary, if any, followed by the
+// description from the schema.
+func comment(out *bytes.Buffer, indent string, description string, summary string) {
+	text := strings.TrimSpace(summary + "\n" + description)
+	if text == "" {
+		return
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(out, "%s// %s\n", indent, strings.TrimSpace(line))
+	}
+}
+
+// initialisms maps words to the casing they get in Go identifiers.
+var initialisms = map[string]string{
+	"AMI": "AMI", "API": "API", "ARN": "ARN", "AWS": "AWS", "ECS": "ECS", "GCP": "GCP",
+	"HTTP": "HTTP", "HTTPS": "HTTPS", "IAM": "IAM", "ID": "ID", "IDS": "IDs", "IIS": "IIS",
+	"JSON": "JSON", "KMS": "KMS", "OIDC": "OIDC", "PCF": "PCF", "SSH": "SSH", "SSL": "SSL",
+	"STS": "STS", "URI": "URI", "URL": "URL", "WINRM": "WinRM", "YAML": "YAML",
+}
+
+// goName turns a camelCase or snake_case GraphQL name into an exported Go
+// identifier, such as secretManagerId into SecretManagerID.
+func goName(name string) string {
+	var sb strings.Builder
+	for _, word := range words(name) {
+		if initialism, ok := initialisms[strings.ToUpper(word)]; ok {
+			sb.WriteString(initialism)
+			continue
+		}
+		runes := []rune(word)
+		sb.WriteRune(unicode.ToUpper(runes[0]))
+		sb.WriteString(string(runes[1:]))
+	}
+	return sb.String()
+}
+
+func words(name string) []string {
+	var out []string
+	for _, part := range strings.Split(name, "_") {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			if !unicode.IsUpper(runes[i]) {
+				continue
+			}
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if !unicode.IsUpper(prev) || nextLower {
+				out = append(out, string(runes[start:i]))
+				start = i
+			}
+		}
+		if start < len(runes) {
+			out = append(out, string(runes[start:]))
+		}
+	}
+	return out
+}
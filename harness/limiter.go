@@ -0,0 +1,114 @@
+package harness
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// WithRateLimit caps the requests sent to Harness.io to requestsPerSecond,
+// using a token bucket that allows bursts of up to a second's worth of
+// requests. Zero, the default, sends requests as fast as they come.
+func WithRateLimit(requestsPerSecond float64) ClientOption {
+	return func(h *Client) {
+		h.limiter.setRate(requestsPerSecond)
+	}
+}
+
+// WithMaxConcurrentRequests caps the requests in flight to Harness.io at
+// once. Zero, the default, does not cap them.
+func WithMaxConcurrentRequests(max int) ClientOption {
+	return func(h *Client) {
+		h.limiter.slots = nil
+		if max > 0 {
+			h.limiter.slots = make(chan struct{}, max)
+		}
+	}
+}
+
+// limiter holds requests back so that a Client stays within its rate and
+// concurrency limits. Retries go through it like any other request.
+type limiter struct {
+	slots chan struct{}
+
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func (l *limiter) setRate(requestsPerSecond float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = 0
+	if requestsPerSecond > 0 {
+		l.rate = requestsPerSecond
+		l.burst = math.Max(1, math.Ceil(requestsPerSecond))
+		l.tokens = l.burst
+		l.last = time.Now()
+	}
+}
+
+// acquire blocks until a request may be sent, returning how long that took
+// and a function to call once the request is done.
+func (l *limiter) acquire(ctx context.Context) (func(), time.Duration, error) {
+	start := time.Now()
+
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, time.Since(start), ctx.Err()
+		}
+		release = func() { <-l.slots }
+	}
+
+	if delay := l.reserve(); delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.cancel()
+			release()
+			return nil, time.Since(start), ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return release, time.Since(start), nil
+}
+
+// reserve takes a token from the bucket, returning how long to wait until
+// that token is actually available.
+func (l *limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate == 0 {
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel gives back a token reserved by a request that was abandoned.
+func (l *limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+1)
+	}
+}
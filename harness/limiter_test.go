@@ -0,0 +1,117 @@
+package harness
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	h := NewClient("key", server.URL, WithMaxConcurrentRequests(3))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := h.query(context.Background(), &GraphQLQuery{OperationName: "q"}, &struct{}{}); err != nil {
+				t.Errorf("query failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got != 3 {
+		t.Errorf("%d requests were in flight at once, want 3", got)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	// A burst of 20 requests goes out at once, the next 20 over a second.
+	h := NewClient("key", server.URL, WithRateLimit(20))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 40; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := h.query(context.Background(), &GraphQLQuery{OperationName: "q"}, &struct{}{}); err != nil {
+				t.Errorf("query failed: %v", err)
+			}
+		}()
+	}
+
+	time.Sleep(500 * time.Millisecond)
+	if got := atomic.LoadInt32(&requests); got > 31 {
+		t.Errorf("sent %d requests within half a second, want at most 31", got)
+	}
+
+	wg.Wait()
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("sent 40 requests in %s, want at least a second", elapsed)
+	}
+}
+
+func TestRateLimitGivesBackAbandonedTokens(t *testing.T) {
+	l := &limiter{}
+	l.setRate(1)
+
+	if _, _, err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := l.acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("acquire returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Had the abandoned request kept its token, this one would wait two
+	// seconds rather than one.
+	if delay := l.reserve(); delay > time.Second {
+		t.Errorf("reserve = %s, want at most a second", delay)
+	}
+}
+
+func TestMaxConcurrentRequestsStopsWhenContextDone(t *testing.T) {
+	h := NewClient("key", "", WithMaxConcurrentRequests(1))
+
+	release, _, err := h.limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire failed: %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := h.limiter.acquire(ctx); err != context.DeadlineExceeded {
+		t.Errorf("acquire returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
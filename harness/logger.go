@@ -0,0 +1,98 @@
+package harness
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Logger receives the diagnostics of a Client: Debugf gets a line per
+// operation and per entity change, Tracef the request and response bodies
+// with secret material redacted.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Tracef(format string, args ...interface{})
+}
+
+// WithLogger sends the diagnostics of the client to logger. Clients log
+// nothing by default.
+func WithLogger(logger Logger) ClientOption {
+	return func(h *Client) {
+		if logger == nil {
+			logger = nopLogger{}
+		}
+		h.logger = logger
+	}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debugf(format string, args ...interface{}) {}
+func (nopLogger) Tracef(format string, args ...interface{}) {}
+
+const redacted = "**REDACTED**"
+
+// sensitiveSuffixes are the lower-cased endings of the JSON keys holding
+// secret material, such as encryptedText.value or an API key.
+var sensitiveSuffixes = []string{
+	"value",
+	"password",
+	"passphrase",
+	"token",
+	"apikey",
+	"secretkey",
+	"privatekey",
+	"clientsecret",
+	"content",
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	if key == "key" {
+		return true
+	}
+
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact returns a JSON document with the string values of every sensitive
+// key replaced, for logging. Bodies that are not JSON are dropped entirely as
+// they cannot be inspected.
+func redact(body []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return "<non-JSON body omitted>"
+	}
+
+	out, err := json.Marshal(redactValue(doc))
+	if err != nil {
+		return "<body omitted>"
+	}
+	return string(out)
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && isSensitive(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+	return v
+}
@@ -0,0 +1,43 @@
+package harness
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		name   string
+		body   string
+		secret string
+	}{
+		{"encrypted text value", `{"variables":{"input":{"encryptedText":{"name":"n","value":"hunter2"}}}}`, "hunter2"},
+		{"password", `{"variables":{"input":{"password":"hunter2"}}}`, "hunter2"},
+		{"winrm password", `{"variables":{"input":{"winRMCredential":{"userName":"u","password":"hunter2"}}}}`, "hunter2"},
+		{"vault token", `{"variables":{"input":{"vaultConfigInput":{"authToken":"s.hunter2"}}}}`, "s.hunter2"},
+		{"token", `{"token":"hunter2"}`, "hunter2"},
+		{"api key", `{"variables":{"apiKey":"hunter2"}}`, "hunter2"},
+		{"key", `{"key":"hunter2"}`, "hunter2"},
+		{"in a list", `{"variables":[{"secrets":[{"value":"hunter2"}]}]}`, "hunter2"},
+		{"file content", `{"encryptedFile":{"content":"aHVudGVyMg=="}}`, "aHVudGVyMg=="},
+		{"not JSON", `value=hunter2`, "hunter2"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := redact([]byte(c.body)); strings.Contains(got, c.secret) {
+				t.Errorf("redact(%s) = %s, which still holds %q", c.body, got, c.secret)
+			}
+		})
+	}
+
+	t.Run("other values", func(t *testing.T) {
+		body := `{"name":"password","secretManagerId":"vault","passwordSecretId":"abc","count":3}`
+		got := redact([]byte(body))
+		for _, kept := range []string{`"name":"password"`, `"secretManagerId":"vault"`, `"passwordSecretId":"abc"`, `"count":3`} {
+			if !strings.Contains(got, kept) {
+				t.Errorf("redact(%s) = %s, want it to keep %s", body, got, kept)
+			}
+		}
+	})
+}
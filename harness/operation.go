@@ -0,0 +1,191 @@
+package harness
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// variable is a typed variable of an operation, such as $input of type
+// CreateApplicationInput!.
+type variable struct {
+	name    string
+	gqlType string
+}
+
+func (v variable) required() bool {
+	return strings.HasSuffix(v.gqlType, "!")
+}
+
+// operation is a named GraphQL document running a single root field. Every
+// argument of the field is passed as the variable of the same name, so values
+// never end up in the document itself.
+//
+// This is the only place documents are assembled; TestOperationsOnlyBuiltByBuilder
+// rejects any other code building them.
+type operation struct {
+	kind      string
+	name      string
+	variables []variable
+	selection string
+
+	// nonIdempotent marks mutations that must not be replayed once Harness
+	// may have processed them, see GraphQLQuery.
+	nonIdempotent bool
+}
+
+// document renders the operation, such as
+//
+//	query application($applicationId: String!) {
+//	  application(applicationId: $applicationId) { id name }
+//	}
+func (o *operation) document() string {
+	var sb strings.Builder
+
+	sb.WriteString(o.kind)
+	sb.WriteString(" ")
+	sb.WriteString(o.name)
+	if len(o.variables) > 0 {
+		sb.WriteString("(")
+		sb.WriteString(o.variableDefinitions(""))
+		sb.WriteString(")")
+	}
+	sb.WriteString(" {\n  ")
+	sb.WriteString(o.field("", ""))
+	sb.WriteString("\n}")
+
+	return sb.String()
+}
+
+// variableDefinitions renders the variable definitions of the operation,
+// prefixing the name of each variable.
+func (o *operation) variableDefinitions(prefix string) string {
+	defs := make([]string, 0, len(o.variables))
+	for _, v := range o.variables {
+		defs = append(defs, "$"+prefix+v.name+": "+v.gqlType)
+	}
+	return strings.Join(defs, ", ")
+}
+
+// field renders the root field of the operation with its selection, under
+// alias when given and using variables prefixed with prefix.
+func (o *operation) field(alias string, prefix string) string {
+	var sb strings.Builder
+
+	if alias != "" {
+		sb.WriteString(alias)
+		sb.WriteString(": ")
+	}
+	sb.WriteString(o.name)
+	if len(o.variables) > 0 {
+		args := make([]string, 0, len(o.variables))
+		for _, v := range o.variables {
+			args = append(args, v.name+": $"+prefix+v.name)
+		}
+		sb.WriteString("(")
+		sb.WriteString(strings.Join(args, ", "))
+		sb.WriteString(")")
+	}
+	if o.selection != "" {
+		sb.WriteString(" ")
+		sb.WriteString(o.selection)
+	}
+
+	return sb.String()
+}
+
+// checkVariables makes sure values holds every required variable of the
+// operation and nothing else.
+func (o *operation) checkVariables(values map[string]interface{}) error {
+	declared := make(map[string]bool, len(o.variables))
+	for _, v := range o.variables {
+		declared[v.name] = true
+		if v.required() && isNil(values[v.name]) {
+			return fmt.Errorf("operation %s: variable $%s of type %s is required", o.name, v.name, v.gqlType)
+		}
+	}
+
+	for name := range values {
+		if !declared[name] {
+			return fmt.Errorf("operation %s: variable $%s is not declared", o.name, name)
+		}
+	}
+
+	return nil
+}
+
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// batchQuery renders calls as a single query, each under its own alias and
+// with its variables prefixed by that alias, such as
+//
+//	query batch($b0_secretId: String!, $b1_secretId: String!) {
+//	  b0: secret(secretId: $b0_secretId) { id name }
+//	  b1: secret(secretId: $b1_secretId) { id name }
+//	}
+func batchQuery(calls []*call) *GraphQLQuery {
+	var sb strings.Builder
+	defs := make([]string, 0, len(calls))
+	values := map[string]interface{}{}
+	for _, c := range calls {
+		prefix := c.alias + "_"
+		if len(c.op.variables) > 0 {
+			defs = append(defs, c.op.variableDefinitions(prefix))
+		}
+		for name, value := range c.values {
+			values[prefix+name] = value
+		}
+	}
+
+	sb.WriteString("query batch")
+	if len(defs) > 0 {
+		sb.WriteString("(")
+		sb.WriteString(strings.Join(defs, ", "))
+		sb.WriteString(")")
+	}
+	sb.WriteString(" {")
+	for _, c := range calls {
+		sb.WriteString("\n  ")
+		sb.WriteString(c.op.field(c.alias, c.alias+"_"))
+	}
+	sb.WriteString("\n}")
+
+	return &GraphQLQuery{
+		OperationName: "batch",
+		Query:         sb.String(),
+		Variables:     values,
+		partialErrors: true,
+	}
+}
+
+// run executes the operation with the given variables and decodes the
+// response into response. Queries are batched with other reads when the
+// client batches them.
+func (h *Client) run(ctx context.Context, o *operation, values map[string]interface{}, response interface{}) error {
+	if err := o.checkVariables(values); err != nil {
+		return err
+	}
+
+	if o.kind == "query" && h.batcher != nil {
+		return h.batcher.run(ctx, o, values, response)
+	}
+
+	return h.query(ctx, &GraphQLQuery{
+		OperationName: o.name,
+		Query:         o.document(),
+		Variables:     values,
+		nonIdempotent: o.nonIdempotent,
+	}, response)
+}
@@ -0,0 +1,194 @@
+package harness
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// builderFile is the file allowed to assemble GraphQL documents.
+const builderFile = "operation.go"
+
+var documentPattern = regexp.MustCompile(`^\s*(query|mutation)\b[^{]*\{`)
+
+// TestOperationsOnlyBuiltByBuilder checks that the package only sends
+// documents assembled by its operation builder, so that values always travel
+// as variables and never get interpolated into a document.
+func TestOperationsOnlyBuiltByBuilder(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, pkg := range pkgs {
+		for path, file := range pkg.Files {
+			for _, problem := range lintFile(fset, path, file) {
+				t.Error(problem)
+			}
+		}
+	}
+}
+
+func TestLintFile(t *testing.T) {
+	cases := []struct {
+		name     string
+		path     string
+		source   string
+		problems int
+	}{
+		{
+			name:   "operation with constant fields",
+			path:   "applications.go",
+			source: `var op = &operation{kind: "query", name: "application", variables: []variable{{"applicationId", "String!"}}, selection: "{ id }"}`,
+		},
+		{
+			name:     "operation with a computed selection",
+			path:     "applications.go",
+			source:   `var op = &operation{kind: "query", name: "application", selection: fmt.Sprintf("{ %s }", fields)}`,
+			problems: 1,
+		},
+		{
+			name:     "GraphQLQuery outside the builder",
+			path:     "applications.go",
+			source:   `var q = &GraphQLQuery{Query: document}`,
+			problems: 1,
+		},
+		{
+			name:   "GraphQLQuery in the builder",
+			path:   builderFile,
+			source: `var q = &GraphQLQuery{Query: document}`,
+		},
+		{
+			name:     "query document assigned outside the builder",
+			path:     "applications.go",
+			source:   `func f(q *GraphQLQuery) { q.Query = document }`,
+			problems: 1,
+		},
+		{
+			name:     "document literal outside the builder",
+			path:     "applications.go",
+			source:   `const document = "query { application(applicationId: \"x\") { id } }"`,
+			problems: 1,
+		},
+		{
+			name:   "string mentioning a query",
+			path:   "applications.go",
+			source: `const message = "querying Harness.io"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, c.path, "package harness\n"+c.source, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			problems := lintFile(fset, c.path, file)
+			if len(problems) != c.problems {
+				t.Errorf("got problems %q, want %d", problems, c.problems)
+			}
+		})
+	}
+}
+
+// lintFile reports:
+//   - GraphQLQuery literals, or assignments to their Query field, outside the
+//     builder
+//   - string literals holding a query or mutation document outside the builder
+//   - operation literals with fields that are not constants
+func lintFile(fset *token.FileSet, path string, file *ast.File) []string {
+	var problems []string
+	report := func(pos token.Pos, format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf("%s: %s", fset.Position(pos), fmt.Sprintf(format, args...)))
+	}
+
+	builder := filepath.Base(path) == builderFile
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CompositeLit:
+			switch typeName(n.Type) {
+			case "GraphQLQuery":
+				if !builder {
+					report(n.Pos(), "GraphQLQuery built outside %s, declare an operation instead", builderFile)
+				}
+			case "operation":
+				checkOperation(n, report)
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if sel, ok := lhs.(*ast.SelectorExpr); ok && sel.Sel.Name == "Query" && !builder {
+					report(lhs.Pos(), "query document assigned outside %s", builderFile)
+				}
+			}
+		case *ast.BasicLit:
+			if n.Kind != token.STRING || builder {
+				return true
+			}
+			if value, err := strconv.Unquote(n.Value); err == nil && documentPattern.MatchString(value) {
+				report(n.Pos(), "GraphQL document written outside %s, declare an operation instead", builderFile)
+			}
+		}
+		return true
+	})
+
+	return problems
+}
+
+// checkOperation reports fields of an operation literal that are not
+// constants, such as a selection assembled with fmt.Sprintf.
+func checkOperation(lit *ast.CompositeLit, report func(token.Pos, string, ...interface{})) {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			report(elt.Pos(), "operation fields must be keyed")
+			continue
+		}
+		if !isConstant(kv.Value) {
+			report(kv.Value.Pos(), "operation field %s must be a constant", kv.Key)
+		}
+	}
+}
+
+func isConstant(e ast.Expr) bool {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		return e.Name == "true" || e.Name == "false"
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+			if !isConstant(elt) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func typeName(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.StarExpr:
+		return typeName(e.X)
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+package harness
+
+import "context"
+
+// defaultPageSize is the number of entities fetched per request when listing,
+// which is also the largest page Harness.io serves.
+const defaultPageSize = 100
+
+// ListOptions controls how a list walks the pages of a Harness.io connection.
+type ListOptions struct {
+	// PageSize is the number of entities fetched per request, defaulting to
+	// (and capped at) 100.
+	PageSize int
+}
+
+func (o *ListOptions) pageSize() int {
+	if o == nil || o.PageSize <= 0 || o.PageSize > defaultPageSize {
+		return defaultPageSize
+	}
+	return o.PageSize
+}
+
+// pageFetcher loads the page starting at offset into the iterator, returning
+// the number of entities it held and where it sits in the full list.
+type pageFetcher func(ctx context.Context, limit int, offset int) (int, *PageInfo, error)
+
+// pager walks the limit/offset pages of a connection on behalf of the typed
+// iterators, fetching the next page only once the current one is consumed.
+type pager struct {
+	fetch    pageFetcher
+	pageSize int
+	offset   int
+
+	// index is the position of the current entity in the page holding size
+	// entities.
+	index int
+	size  int
+
+	done bool
+	err  error
+}
+
+func newPager(pageSize int, fetch pageFetcher) pager {
+	return pager{fetch: fetch, pageSize: pageSize, index: -1}
+}
+
+// Next advances to the next entity, fetching a new page when needed. It
+// returns false once every entity has been visited or a request failed, in
+// which case Err reports why.
+func (p *pager) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.index++
+	for p.index >= p.size {
+		if p.done {
+			return false
+		}
+
+		n, info, err := p.fetch(ctx, p.pageSize, p.offset)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.index, p.size = 0, n
+		p.offset += n
+		p.done = n == 0 || info == nil || !info.HasMore
+	}
+
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *pager) Err() error {
+	return p.err
+}
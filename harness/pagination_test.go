@@ -0,0 +1,201 @@
+package harness_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+)
+
+// pageLogger keeps the offset of every page a client fetches.
+type pageLogger struct {
+	mu      sync.Mutex
+	offsets []int
+}
+
+func (l *pageLogger) Debugf(format string, args ...interface{}) {
+	if !strings.HasPrefix(format, "Listing Harness.io") {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.offsets = append(l.offsets, args[0].(int))
+}
+
+func (l *pageLogger) Tracef(format string, args ...interface{}) {}
+
+func (l *pageLogger) pages() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return fmt.Sprint(l.offsets)
+}
+
+func TestListApplications(t *testing.T) {
+	cases := []struct {
+		name      string
+		count     int
+		pageSize  int
+		wantPages string
+	}{
+		{"several pages", 5, 2, "[0 2 4]"},
+		{"full last page", 4, 2, "[0 2]"},
+		{"single page", 3, 0, "[0]"},
+		{"none", 0, 2, "[0]"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := harnesstest.NewServer()
+			defer server.Close()
+			ctx := context.Background()
+
+			want := newApplications(t, server, c.count)
+
+			logger := &pageLogger{}
+			it := server.Client(harness.WithLogger(logger)).ListApplications(&harness.ListOptions{PageSize: c.pageSize})
+
+			var got []string
+			for it.Next(ctx) {
+				got = append(got, it.Application().ID)
+			}
+			if err := it.Err(); err != nil {
+				t.Fatalf("ListApplications: %v", err)
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("listed %v, want %v", got, want)
+			}
+			if pages := logger.pages(); pages != c.wantPages {
+				t.Errorf("fetched pages at offsets %s, want %s", pages, c.wantPages)
+			}
+			if it.Next(ctx) {
+				t.Error("Next returned true after the last application")
+			}
+			if pages := logger.pages(); pages != c.wantPages {
+				t.Errorf("fetched pages at offsets %s after the end, want %s", pages, c.wantPages)
+			}
+		})
+	}
+}
+
+func TestListApplicationsFailingPartway(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	newApplications(t, server, 5)
+
+	logger := &pageLogger{}
+	it := server.Client(harness.WithLogger(logger)).ListApplications(&harness.ListOptions{PageSize: 2})
+
+	listed := 0
+	for it.Next(ctx) {
+		listed++
+		if listed == 2 {
+			server.FailNext(1, http.StatusInternalServerError, 0)
+		}
+	}
+
+	if listed != 2 {
+		t.Errorf("listed %d applications, want the 2 of the first page", listed)
+	}
+	if err := it.Err(); !errors.Is(err, harness.ErrServerError) {
+		t.Errorf("Err = %v, want ErrServerError", err)
+	}
+
+	if it.Next(ctx) {
+		t.Error("Next returned true after a failed page")
+	}
+	if pages := logger.pages(); pages != "[0 2]" {
+		t.Errorf("fetched pages at offsets %s, want [0 2]", pages)
+	}
+}
+
+func TestListCloudProvidersFiltersTypes(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	token, err := client.NewEncryptedSecret(ctx, &harness.EncryptedSecret{Name: "token", Value: "token", SecretManagerID: "builtin"})
+	if err != nil {
+		t.Fatalf("NewEncryptedSecret: %v", err)
+	}
+
+	var want []string
+	for i := 0; i < 3; i++ {
+		cp, err := client.NewCloudProviderGcp(ctx, fmt.Sprintf("gcp-%d", i), "", []string{"gcp"}, false, nil)
+		if err != nil {
+			t.Fatalf("NewCloudProviderGcp: %v", err)
+		}
+		want = append(want, cp.ID)
+
+		if _, err := client.NewCloudProviderKubernetes(ctx, fmt.Sprintf("k8s-%d", i), token.ID, "https://k8s.example.com"); err != nil {
+			t.Fatalf("NewCloudProviderKubernetes: %v", err)
+		}
+	}
+
+	it := client.ListCloudProviders(&harness.ListCloudProvidersOptions{
+		ListOptions: harness.ListOptions{PageSize: 2},
+		Types:       []harness.CloudProviderType{harness.CloudProviderTypeGCP},
+	})
+
+	var got []string
+	for it.Next(ctx) {
+		got = append(got, it.CloudProvider().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ListCloudProviders: %v", err)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("listed %v, want the GCP cloud providers %v", got, want)
+	}
+}
+
+func TestListSecretsFiltersSecretManagers(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	var want []string
+	for i := 0; i < 3; i++ {
+		for _, secretManagerID := range []string{"vault", "builtin"} {
+			secret, err := client.NewEncryptedSecret(ctx, &harness.EncryptedSecret{
+				Name:            fmt.Sprintf("%s-%d", secretManagerID, i),
+				Value:           "value",
+				SecretManagerID: secretManagerID,
+			})
+			if err != nil {
+				t.Fatalf("NewEncryptedSecret: %v", err)
+			}
+			if secretManagerID == "vault" {
+				want = append(want, secret.ID)
+			}
+		}
+	}
+
+	it := client.ListSecrets(&harness.ListSecretsOptions{
+		ListOptions:      harness.ListOptions{PageSize: 2},
+		Types:            []harness.SecretType{harness.SecretTypeEncryptedText},
+		SecretManagerIDs: []string{"vault"},
+	})
+
+	var got []string
+	for it.Next(ctx) {
+		got = append(got, it.Secret().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ListSecrets: %v", err)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("listed %v, want the secrets of vault %v", got, want)
+	}
+}
@@ -0,0 +1,25 @@
+package harness
+
+// String returns a pointer to v, for the optional fields of input types.
+func String(v string) *string {
+	return &v
+}
+
+// Bool returns a pointer to v, for the optional fields of input types.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Int returns a pointer to v, for the optional fields of input types.
+func Int(v int) *int {
+	return &v
+}
+
+// optionalString returns a pointer to v, or nil to leave the field out when
+// v is empty.
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
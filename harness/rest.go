@@ -0,0 +1,115 @@
+package harness
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// restResponse is the envelope of the responses of the Harness.io REST API.
+type restResponse struct {
+	Resource         json.RawMessage   `json:"resource"`
+	ResponseMessages []responseMessage `json:"responseMessages"`
+}
+
+type responseMessage struct {
+	Code    string `json:"code"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// restRequest is a call to an endpoint of the Harness.io REST API, which
+// lives next to the GraphQL endpoint of the client.
+type restRequest struct {
+	name        string
+	method      string
+	path        string
+	params      url.Values
+	body        []byte
+	contentType string
+}
+
+// restURL returns the URL of a REST endpoint, derived from the GraphQL
+// endpoint of the client and keeping its accountId.
+func (h *Client) restURL(path string, params url.Values) (string, error) {
+	u, err := url.Parse(h.endpoint)
+	if err != nil {
+		return "", fmt.Errorf("parsing Harness.io endpoint: %w", err)
+	}
+
+	query := u.Query()
+	for k, v := range params {
+		query[k] = v
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/graphql") + path
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// rest sends r and decodes the resource of its response into resource.
+func (h *Client) rest(ctx context.Context, r *restRequest, resource interface{}) error {
+	endpoint, err := h.restURL(r.path, r.params)
+	if err != nil {
+		return err
+	}
+
+	// Like deleting through GraphQL, a replayed delete would fail as not
+	// found if the first attempt went through.
+	return h.retry(ctx, r.name, r.method == http.MethodDelete, func() error {
+		req, err := http.NewRequestWithContext(ctx, r.method, endpoint, bytes.NewReader(r.body))
+		if err != nil {
+			return err
+		}
+		if r.contentType != "" {
+			req.Header.Set("content-type", r.contentType)
+		}
+
+		res, body, err := h.send(ctx, r.name, req, r.body)
+		if err != nil {
+			return err
+		}
+
+		if err := gatewayError(res, r.name); err != nil {
+			return err
+		}
+
+		envelope := &restResponse{}
+		if err := json.Unmarshal(body, envelope); err != nil {
+			if res.StatusCode >= 400 {
+				return newStatusError(res.StatusCode, r.name)
+			}
+			return fmt.Errorf("decoding Harness.io response: %w", err)
+		}
+
+		if errs := failures(envelope.ResponseMessages); len(errs) > 0 {
+			return newRESTError(res.StatusCode, r.name, errs)
+		}
+
+		if res.StatusCode >= 400 {
+			return newStatusError(res.StatusCode, r.name)
+		}
+
+		if resource == nil || len(envelope.Resource) == 0 {
+			return nil
+		}
+		return json.Unmarshal(envelope.Resource, resource)
+	})
+}
+
+// failures returns the response messages reporting an error, Harness.io also
+// sending informational ones.
+func failures(messages []responseMessage) []responseMessage {
+	var errs []responseMessage
+	for _, m := range messages {
+		if m.Level == "ERROR" {
+			errs = append(errs, m)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,45 @@
+package harness
+
+import (
+	"context"
+)
+
+// GetSecretManager fetches a secret manager of any type by its id.
+func (h *Client) GetSecretManager(ctx context.Context, id string) (*SecretManager, error) {
+	sm, err := h.secretManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sm == nil {
+		return nil, newNotFoundError("secret manager")
+	}
+
+	return sm, nil
+}
+
+// GetSecretManagerByName fetches a secret manager of any type by its name.
+func (h *Client) GetSecretManagerByName(ctx context.Context, name string) (*SecretManager, error) {
+	sm, err := h.secretManagerByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if sm == nil {
+		return nil, newNotFoundError("secret manager")
+	}
+
+	return sm, nil
+}
+
+// DeleteSecretManager deletes a secret manager of any type by its id.
+// Harness.io refuses to delete secret managers still holding secrets.
+func (h *Client) DeleteSecretManager(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io secret manager with id '%s'", id)
+
+	_, err := h.deleteSecretManager(ctx, &DeleteSecretManagerInput{
+		SecretManagerID: id,
+	})
+
+	return err
+}
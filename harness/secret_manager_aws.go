@@ -0,0 +1,226 @@
+package harness
+
+import "context"
+
+// AWSSecretsManager is an AWS Secrets Manager secret manager, storing the
+// secrets under SecretNamePrefix in Region.
+type AWSSecretsManager struct {
+	ID                string                       `json:"id"`
+	Name              string                       `json:"name"`
+	Region            string                       `json:"region"`
+	SecretNamePrefix  string                       `json:"secretNamePrefix"`
+	Credentials       *AwsSecretManagerCredentials `json:"credentials"`
+	DelegateSelectors []string                     `json:"delegateSelectors"`
+	Default           bool                         `json:"isDefault"`
+	UsageScope        *UsageScope                  `json:"usageScope"`
+}
+
+// AWSKMSSecretManager is an AWS KMS secret manager, encrypting the secrets
+// with the KMS key of KMSARN.
+type AWSKMSSecretManager struct {
+	ID                string                       `json:"id"`
+	Name              string                       `json:"name"`
+	Region            string                       `json:"region"`
+	KMSARN            string                       `json:"kmsArn"`
+	Credentials       *AwsSecretManagerCredentials `json:"credentials"`
+	DelegateSelectors []string                     `json:"delegateSelectors"`
+	Default           bool                         `json:"isDefault"`
+	UsageScope        *UsageScope                  `json:"usageScope"`
+}
+
+func (h *Client) GetAWSSecretsManager(ctx context.Context, id string) (*AWSSecretsManager, error) {
+	h.logger.Debugf("Getting a Harness.io AWS Secrets Manager secret manager with id '%s'", id)
+
+	sm, err := h.GetSecretManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return awsSecretsManager(sm)
+}
+
+func (h *Client) NewAWSSecretsManager(ctx context.Context, a *AWSSecretsManager) (*AWSSecretsManager, error) {
+	h.logger.Debugf("Creating a Harness.io AWS Secrets Manager secret manager with name '%s'", a.Name)
+
+	input := &AwsSecretsManagerConfigInput{
+		Name:              a.Name,
+		Region:            a.Region,
+		Credentials:       awsSecretManagerCredentialsInput(a.Credentials),
+		DelegateSelectors: a.DelegateSelectors,
+		IsDefault:         Bool(a.Default),
+		UsageScope:        usageScopeInput(a.UsageScope),
+	}
+	if a.SecretNamePrefix != "" {
+		input.SecretNamePrefix = String(a.SecretNamePrefix)
+	}
+
+	payload, err := h.createSecretManager(ctx, &CreateSecretManagerInput{
+		SecretManagerType:            SecretManagerTypeAWSSecretsManager,
+		AWSSecretsManagerConfigInput: input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("AWS Secrets Manager secret manager")
+	}
+
+	return awsSecretsManager(payload.SecretManager)
+}
+
+// UpdateAWSSecretsManager updates an AWS Secrets Manager secret manager. Its
+// region and secret name prefix cannot be changed.
+func (h *Client) UpdateAWSSecretsManager(ctx context.Context, a *AWSSecretsManager) (*AWSSecretsManager, error) {
+	h.logger.Debugf("Updating a Harness.io AWS Secrets Manager secret manager with id '%s'", a.ID)
+
+	payload, err := h.updateSecretManager(ctx, &UpdateSecretManagerInput{
+		SecretManagerID:   a.ID,
+		SecretManagerType: SecretManagerTypeAWSSecretsManager,
+		AWSSecretsManagerConfigInput: &UpdateAwsSecretsManagerConfigInput{
+			Name:              String(a.Name),
+			Credentials:       awsSecretManagerCredentialsInput(a.Credentials),
+			DelegateSelectors: a.DelegateSelectors,
+			IsDefault:         Bool(a.Default),
+			UsageScope:        usageScopeInput(a.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("AWS Secrets Manager secret manager")
+	}
+
+	return awsSecretsManager(payload.SecretManager)
+}
+
+func (h *Client) DeleteAWSSecretsManager(ctx context.Context, id string) error {
+	return h.DeleteSecretManager(ctx, id)
+}
+
+func (h *Client) GetAWSKMSSecretManager(ctx context.Context, id string) (*AWSKMSSecretManager, error) {
+	h.logger.Debugf("Getting a Harness.io AWS KMS secret manager with id '%s'", id)
+
+	sm, err := h.GetSecretManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return awsKMSSecretManager(sm)
+}
+
+func (h *Client) NewAWSKMSSecretManager(ctx context.Context, a *AWSKMSSecretManager) (*AWSKMSSecretManager, error) {
+	h.logger.Debugf("Creating a Harness.io AWS KMS secret manager with name '%s'", a.Name)
+
+	payload, err := h.createSecretManager(ctx, &CreateSecretManagerInput{
+		SecretManagerType: SecretManagerTypeAWSKMS,
+		AWSKMSConfigInput: &AwsKmsConfigInput{
+			Name:              a.Name,
+			Region:            a.Region,
+			KMSARN:            a.KMSARN,
+			Credentials:       awsSecretManagerCredentialsInput(a.Credentials),
+			DelegateSelectors: a.DelegateSelectors,
+			IsDefault:         Bool(a.Default),
+			UsageScope:        usageScopeInput(a.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("AWS KMS secret manager")
+	}
+
+	return awsKMSSecretManager(payload.SecretManager)
+}
+
+// UpdateAWSKMSSecretManager updates an AWS KMS secret manager. Its region and
+// key cannot be changed.
+func (h *Client) UpdateAWSKMSSecretManager(ctx context.Context, a *AWSKMSSecretManager) (*AWSKMSSecretManager, error) {
+	h.logger.Debugf("Updating a Harness.io AWS KMS secret manager with id '%s'", a.ID)
+
+	payload, err := h.updateSecretManager(ctx, &UpdateSecretManagerInput{
+		SecretManagerID:   a.ID,
+		SecretManagerType: SecretManagerTypeAWSKMS,
+		AWSKMSConfigInput: &UpdateAwsKmsConfigInput{
+			Name:              String(a.Name),
+			Credentials:       awsSecretManagerCredentialsInput(a.Credentials),
+			DelegateSelectors: a.DelegateSelectors,
+			IsDefault:         Bool(a.Default),
+			UsageScope:        usageScopeInput(a.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("AWS KMS secret manager")
+	}
+
+	return awsKMSSecretManager(payload.SecretManager)
+}
+
+func (h *Client) DeleteAWSKMSSecretManager(ctx context.Context, id string) error {
+	return h.DeleteSecretManager(ctx, id)
+}
+
+func awsSecretManagerCredentialsInput(c *AwsSecretManagerCredentials) *AwsSecretManagerCredentialsInput {
+	if c == nil {
+		return nil
+	}
+
+	input := &AwsSecretManagerCredentialsInput{CredentialType: c.CredentialType}
+	switch c.CredentialType {
+	case AwsSecretManagerCredentialTypeAccessKey:
+		input.AccessKey = String(c.AccessKey)
+		input.SecretKeySecretID = String(c.SecretKeySecretID)
+	case AwsSecretManagerCredentialTypeAssumeSTSRole:
+		input.RoleARN = String(c.RoleARN)
+		if c.ExternalID != "" {
+			input.ExternalID = String(c.ExternalID)
+		}
+		if c.AssumeSTSRoleDuration != 0 {
+			input.AssumeSTSRoleDuration = Int(c.AssumeSTSRoleDuration)
+		}
+	}
+
+	return input
+}
+
+func awsSecretsManager(sm *SecretManager) (*AWSSecretsManager, error) {
+	if sm.SecretManagerType != SecretManagerTypeAWSSecretsManager {
+		return nil, newNotFoundError("AWS Secrets Manager secret manager")
+	}
+
+	return &AWSSecretsManager{
+		ID:                sm.ID,
+		Name:              sm.Name,
+		Region:            sm.Region,
+		SecretNamePrefix:  sm.SecretNamePrefix,
+		Credentials:       sm.Credentials,
+		DelegateSelectors: sm.DelegateSelectors,
+		Default:           sm.IsDefault,
+		UsageScope:        sm.UsageScope,
+	}, nil
+}
+
+func awsKMSSecretManager(sm *SecretManager) (*AWSKMSSecretManager, error) {
+	if sm.SecretManagerType != SecretManagerTypeAWSKMS {
+		return nil, newNotFoundError("AWS KMS secret manager")
+	}
+
+	return &AWSKMSSecretManager{
+		ID:                sm.ID,
+		Name:              sm.Name,
+		Region:            sm.Region,
+		KMSARN:            sm.KMSARN,
+		Credentials:       sm.Credentials,
+		DelegateSelectors: sm.DelegateSelectors,
+		Default:           sm.IsDefault,
+		UsageScope:        sm.UsageScope,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package harness
+
+import "context"
+
+// AzureKeyVaultSecretManager is an Azure Key Vault secret manager,
+// authenticating as the application of ClientID with the secret held in the
+// encrypted text of ClientSecretID.
+type AzureKeyVaultSecretManager struct {
+	ID              string               `json:"id"`
+	Name            string               `json:"name"`
+	VaultName       string               `json:"vaultName"`
+	Subscription    string               `json:"subscription"`
+	ClientID        string               `json:"clientId"`
+	TenantID        string               `json:"tenantId"`
+	ClientSecretID  string               `json:"clientSecretId"`
+	EnvironmentType AzureEnvironmentType `json:"azureEnvironmentType"`
+	Default         bool                 `json:"isDefault"`
+	UsageScope      *UsageScope          `json:"usageScope"`
+}
+
+func (h *Client) GetAzureKeyVaultSecretManager(ctx context.Context, id string) (*AzureKeyVaultSecretManager, error) {
+	h.logger.Debugf("Getting a Harness.io Azure Key Vault secret manager with id '%s'", id)
+
+	sm, err := h.GetSecretManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return azureKeyVaultSecretManager(sm)
+}
+
+// GetAzureKeyVaultSecretManagerByName fetches an Azure Key Vault secret
+// manager by its name, failing with ErrNotFound when the secret manager of
+// that name is of another type.
+func (h *Client) GetAzureKeyVaultSecretManagerByName(ctx context.Context, name string) (*AzureKeyVaultSecretManager, error) {
+	h.logger.Debugf("Getting a Harness.io Azure Key Vault secret manager with name '%s'", name)
+
+	sm, err := h.GetSecretManagerByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return azureKeyVaultSecretManager(sm)
+}
+
+func (h *Client) NewAzureKeyVaultSecretManager(ctx context.Context, a *AzureKeyVaultSecretManager) (*AzureKeyVaultSecretManager, error) {
+	h.logger.Debugf("Creating a Harness.io Azure Key Vault secret manager with name '%s'", a.Name)
+
+	payload, err := h.createSecretManager(ctx, &CreateSecretManagerInput{
+		SecretManagerType: SecretManagerTypeAzureKeyVault,
+		AzureVaultConfigInput: &AzureVaultSecretManagerInput{
+			Name:                 a.Name,
+			VaultName:            a.VaultName,
+			Subscription:         a.Subscription,
+			ClientID:             a.ClientID,
+			TenantID:             a.TenantID,
+			ClientSecretID:       a.ClientSecretID,
+			AzureEnvironmentType: a.EnvironmentType,
+			IsDefault:            Bool(a.Default),
+			UsageScope:           usageScopeInput(a.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("Azure Key Vault secret manager")
+	}
+
+	return azureKeyVaultSecretManager(payload.SecretManager)
+}
+
+// UpdateAzureKeyVaultSecretManager updates an Azure Key Vault secret
+// manager. Its vault, subscription and environment cannot be changed.
+func (h *Client) UpdateAzureKeyVaultSecretManager(ctx context.Context, a *AzureKeyVaultSecretManager) (*AzureKeyVaultSecretManager, error) {
+	h.logger.Debugf("Updating a Harness.io Azure Key Vault secret manager with id '%s'", a.ID)
+
+	payload, err := h.updateSecretManager(ctx, &UpdateSecretManagerInput{
+		SecretManagerID:   a.ID,
+		SecretManagerType: SecretManagerTypeAzureKeyVault,
+		AzureVaultConfigInput: &UpdateAzureVaultInput{
+			Name:           String(a.Name),
+			ClientID:       String(a.ClientID),
+			TenantID:       String(a.TenantID),
+			ClientSecretID: String(a.ClientSecretID),
+			IsDefault:      Bool(a.Default),
+			UsageScope:     usageScopeInput(a.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("Azure Key Vault secret manager")
+	}
+
+	return azureKeyVaultSecretManager(payload.SecretManager)
+}
+
+func (h *Client) DeleteAzureKeyVaultSecretManager(ctx context.Context, id string) error {
+	return h.DeleteSecretManager(ctx, id)
+}
+
+func azureKeyVaultSecretManager(sm *SecretManager) (*AzureKeyVaultSecretManager, error) {
+	if sm.SecretManagerType != SecretManagerTypeAzureKeyVault {
+		return nil, newNotFoundError("Azure Key Vault secret manager")
+	}
+
+	return &AzureKeyVaultSecretManager{
+		ID:              sm.ID,
+		Name:            sm.Name,
+		VaultName:       sm.VaultName,
+		Subscription:    sm.Subscription,
+		ClientID:        sm.ClientID,
+		TenantID:        sm.TenantID,
+		ClientSecretID:  sm.ClientSecretID,
+		EnvironmentType: sm.AzureEnvironmentType,
+		Default:         sm.IsDefault,
+		UsageScope:      sm.UsageScope,
+	}, nil
+}
@@ -0,0 +1,221 @@
+package harness
+
+import "context"
+
+// GCPKMSSecretManager is a Google Cloud KMS secret manager, encrypting the
+// secrets with the key KeyName of KeyRing and authenticating with the service
+// account key held in the encrypted file of CredentialsFileSecretID.
+type GCPKMSSecretManager struct {
+	ID                      string      `json:"id"`
+	Name                    string      `json:"name"`
+	ProjectID               string      `json:"projectId"`
+	Region                  string      `json:"region"`
+	KeyRing                 string      `json:"keyRing"`
+	KeyName                 string      `json:"keyName"`
+	CredentialsFileSecretID string      `json:"credentialsFileSecretId"`
+	Default                 bool        `json:"isDefault"`
+	UsageScope              *UsageScope `json:"usageScope"`
+}
+
+// GCPSecretsManager is a GCP Secret Manager secret manager, storing the
+// secrets in the project of the service account whose key is held in the
+// encrypted file of CredentialsFileSecretID.
+type GCPSecretsManager struct {
+	ID                      string      `json:"id"`
+	Name                    string      `json:"name"`
+	ProjectID               string      `json:"projectId"`
+	CredentialsFileSecretID string      `json:"credentialsFileSecretId"`
+	Default                 bool        `json:"isDefault"`
+	UsageScope              *UsageScope `json:"usageScope"`
+}
+
+func (h *Client) GetGCPKMSSecretManager(ctx context.Context, id string) (*GCPKMSSecretManager, error) {
+	h.logger.Debugf("Getting a Harness.io GCP KMS secret manager with id '%s'", id)
+
+	sm, err := h.GetSecretManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcpKMSSecretManager(sm)
+}
+
+// GetGCPKMSSecretManagerByName fetches a GCP KMS secret manager by its name,
+// failing with ErrNotFound when the secret manager of that name is of another
+// type.
+func (h *Client) GetGCPKMSSecretManagerByName(ctx context.Context, name string) (*GCPKMSSecretManager, error) {
+	h.logger.Debugf("Getting a Harness.io GCP KMS secret manager with name '%s'", name)
+
+	sm, err := h.GetSecretManagerByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcpKMSSecretManager(sm)
+}
+
+func (h *Client) NewGCPKMSSecretManager(ctx context.Context, g *GCPKMSSecretManager) (*GCPKMSSecretManager, error) {
+	h.logger.Debugf("Creating a Harness.io GCP KMS secret manager with name '%s'", g.Name)
+
+	payload, err := h.createSecretManager(ctx, &CreateSecretManagerInput{
+		SecretManagerType: SecretManagerTypeGCPKMS,
+		GCPKMSConfigInput: &GcpKmsConfigInput{
+			Name:                    g.Name,
+			ProjectID:               g.ProjectID,
+			Region:                  g.Region,
+			KeyRing:                 g.KeyRing,
+			KeyName:                 g.KeyName,
+			CredentialsFileSecretID: g.CredentialsFileSecretID,
+			IsDefault:               Bool(g.Default),
+			UsageScope:              usageScopeInput(g.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("GCP KMS secret manager")
+	}
+
+	return gcpKMSSecretManager(payload.SecretManager)
+}
+
+// UpdateGCPKMSSecretManager updates a GCP KMS secret manager. Its project,
+// region and key cannot be changed.
+func (h *Client) UpdateGCPKMSSecretManager(ctx context.Context, g *GCPKMSSecretManager) (*GCPKMSSecretManager, error) {
+	h.logger.Debugf("Updating a Harness.io GCP KMS secret manager with id '%s'", g.ID)
+
+	payload, err := h.updateSecretManager(ctx, &UpdateSecretManagerInput{
+		SecretManagerID:   g.ID,
+		SecretManagerType: SecretManagerTypeGCPKMS,
+		GCPKMSConfigInput: &UpdateGcpKmsConfigInput{
+			Name:                    String(g.Name),
+			CredentialsFileSecretID: String(g.CredentialsFileSecretID),
+			IsDefault:               Bool(g.Default),
+			UsageScope:              usageScopeInput(g.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("GCP KMS secret manager")
+	}
+
+	return gcpKMSSecretManager(payload.SecretManager)
+}
+
+func (h *Client) DeleteGCPKMSSecretManager(ctx context.Context, id string) error {
+	return h.DeleteSecretManager(ctx, id)
+}
+
+func (h *Client) GetGCPSecretsManager(ctx context.Context, id string) (*GCPSecretsManager, error) {
+	h.logger.Debugf("Getting a Harness.io GCP Secret Manager secret manager with id '%s'", id)
+
+	sm, err := h.GetSecretManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcpSecretsManager(sm)
+}
+
+// GetGCPSecretsManagerByName fetches a GCP Secret Manager secret manager by
+// its name, failing with ErrNotFound when the secret manager of that name is
+// of another type.
+func (h *Client) GetGCPSecretsManagerByName(ctx context.Context, name string) (*GCPSecretsManager, error) {
+	h.logger.Debugf("Getting a Harness.io GCP Secret Manager secret manager with name '%s'", name)
+
+	sm, err := h.GetSecretManagerByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcpSecretsManager(sm)
+}
+
+func (h *Client) NewGCPSecretsManager(ctx context.Context, g *GCPSecretsManager) (*GCPSecretsManager, error) {
+	h.logger.Debugf("Creating a Harness.io GCP Secret Manager secret manager with name '%s'", g.Name)
+
+	payload, err := h.createSecretManager(ctx, &CreateSecretManagerInput{
+		SecretManagerType: SecretManagerTypeGCPSecretsManager,
+		GCPSecretsManagerConfigInput: &GcpSecretsManagerConfigInput{
+			Name:                    g.Name,
+			CredentialsFileSecretID: g.CredentialsFileSecretID,
+			IsDefault:               Bool(g.Default),
+			UsageScope:              usageScopeInput(g.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("GCP Secret Manager secret manager")
+	}
+
+	return gcpSecretsManager(payload.SecretManager)
+}
+
+func (h *Client) UpdateGCPSecretsManager(ctx context.Context, g *GCPSecretsManager) (*GCPSecretsManager, error) {
+	h.logger.Debugf("Updating a Harness.io GCP Secret Manager secret manager with id '%s'", g.ID)
+
+	payload, err := h.updateSecretManager(ctx, &UpdateSecretManagerInput{
+		SecretManagerID:   g.ID,
+		SecretManagerType: SecretManagerTypeGCPSecretsManager,
+		GCPSecretsManagerConfigInput: &UpdateGcpSecretsManagerConfigInput{
+			Name:                    String(g.Name),
+			CredentialsFileSecretID: String(g.CredentialsFileSecretID),
+			IsDefault:               Bool(g.Default),
+			UsageScope:              usageScopeInput(g.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("GCP Secret Manager secret manager")
+	}
+
+	return gcpSecretsManager(payload.SecretManager)
+}
+
+func (h *Client) DeleteGCPSecretsManager(ctx context.Context, id string) error {
+	return h.DeleteSecretManager(ctx, id)
+}
+
+func gcpKMSSecretManager(sm *SecretManager) (*GCPKMSSecretManager, error) {
+	if sm.SecretManagerType != SecretManagerTypeGCPKMS {
+		return nil, newNotFoundError("GCP KMS secret manager")
+	}
+
+	return &GCPKMSSecretManager{
+		ID:                      sm.ID,
+		Name:                    sm.Name,
+		ProjectID:               sm.ProjectID,
+		Region:                  sm.Region,
+		KeyRing:                 sm.KeyRing,
+		KeyName:                 sm.KeyName,
+		CredentialsFileSecretID: sm.CredentialsFileSecretID,
+		Default:                 sm.IsDefault,
+		UsageScope:              sm.UsageScope,
+	}, nil
+}
+
+func gcpSecretsManager(sm *SecretManager) (*GCPSecretsManager, error) {
+	if sm.SecretManagerType != SecretManagerTypeGCPSecretsManager {
+		return nil, newNotFoundError("GCP Secret Manager secret manager")
+	}
+
+	return &GCPSecretsManager{
+		ID:                      sm.ID,
+		Name:                    sm.Name,
+		ProjectID:               sm.ProjectID,
+		CredentialsFileSecretID: sm.CredentialsFileSecretID,
+		Default:                 sm.IsDefault,
+		UsageScope:              sm.UsageScope,
+	}, nil
+}
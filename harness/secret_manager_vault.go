@@ -0,0 +1,138 @@
+package harness
+
+import "context"
+
+// VaultSecretManager is a HashiCorp Vault secret manager, authenticating
+// either with AuthToken or with the AppRole of AppRoleID and SecretID.
+// Harness.io never returns the token or the secret id.
+type VaultSecretManager struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	VaultURL  string `json:"vaultUrl"`
+	BasePath  string `json:"basePath"`
+	Namespace string `json:"namespace"`
+
+	AuthToken string `json:"authToken"`
+	AppRoleID string `json:"appRoleId"`
+	SecretID  string `json:"secretId"`
+
+	SecretEngineName    string `json:"secretEngineName"`
+	SecretEngineVersion int    `json:"secretEngineVersion"`
+	// RenewalInterval is how often the token is renewed, in minutes, or 0
+	// to never renew it.
+	RenewalInterval int `json:"secretEngineRenewalInterval"`
+
+	ReadOnly   bool        `json:"isReadOnly"`
+	Default    bool        `json:"isDefault"`
+	UsageScope *UsageScope `json:"usageScope"`
+}
+
+func (h *Client) GetVaultSecretManager(ctx context.Context, id string) (*VaultSecretManager, error) {
+	h.logger.Debugf("Getting a Harness.io Vault secret manager with id '%s'", id)
+
+	sm, err := h.GetSecretManager(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if sm.SecretManagerType != SecretManagerTypeHashicorpVault {
+		return nil, newNotFoundError("Vault secret manager")
+	}
+
+	return vaultSecretManager(sm), nil
+}
+
+func (h *Client) NewVaultSecretManager(ctx context.Context, v *VaultSecretManager) (*VaultSecretManager, error) {
+	h.logger.Debugf("Creating a Harness.io Vault secret manager with name '%s'", v.Name)
+
+	payload, err := h.createSecretManager(ctx, &CreateSecretManagerInput{
+		SecretManagerType: SecretManagerTypeHashicorpVault,
+		HashicorpVaultConfigInput: &HashicorpVaultSecretManagerInput{
+			Name:                        v.Name,
+			VaultURL:                    v.VaultURL,
+			BasePath:                    optionalString(v.BasePath),
+			Namespace:                   optionalString(v.Namespace),
+			AuthDetails:                 vaultAuthDetails(v),
+			SecretEngineName:            v.SecretEngineName,
+			SecretEngineVersion:         v.SecretEngineVersion,
+			SecretEngineRenewalInterval: v.RenewalInterval,
+			IsReadOnly:                  Bool(v.ReadOnly),
+			IsDefault:                   Bool(v.Default),
+			UsageScope:                  usageScopeInput(v.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("Vault secret manager")
+	}
+
+	return vaultSecretManager(payload.SecretManager), nil
+}
+
+// UpdateVaultSecretManager updates a Vault secret manager, only replacing
+// how it authenticates when v has a token or an AppRole. Its URL, base path
+// and secret engine cannot be changed.
+func (h *Client) UpdateVaultSecretManager(ctx context.Context, v *VaultSecretManager) (*VaultSecretManager, error) {
+	h.logger.Debugf("Updating a Harness.io Vault secret manager with id '%s'", v.ID)
+
+	input := &UpdateHashicorpVaultInput{
+		Name:                        String(v.Name),
+		Namespace:                   String(v.Namespace),
+		SecretEngineRenewalInterval: Int(v.RenewalInterval),
+		IsReadOnly:                  Bool(v.ReadOnly),
+		IsDefault:                   Bool(v.Default),
+		UsageScope:                  usageScopeInput(v.UsageScope),
+	}
+	if v.AuthToken != "" || v.AppRoleID != "" {
+		input.AuthDetails = vaultAuthDetails(v)
+	}
+
+	payload, err := h.updateSecretManager(ctx, &UpdateSecretManagerInput{
+		SecretManagerID:           v.ID,
+		SecretManagerType:         SecretManagerTypeHashicorpVault,
+		HashicorpVaultConfigInput: input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.SecretManager == nil {
+		return nil, newNotFoundError("Vault secret manager")
+	}
+
+	return vaultSecretManager(payload.SecretManager), nil
+}
+
+func (h *Client) DeleteVaultSecretManager(ctx context.Context, id string) error {
+	return h.DeleteSecretManager(ctx, id)
+}
+
+func vaultAuthDetails(v *VaultSecretManager) *HashicorpVaultAuthDetails {
+	if v.AppRoleID != "" {
+		return &HashicorpVaultAuthDetails{
+			AppRoleID: String(v.AppRoleID),
+			SecretID:  String(v.SecretID),
+		}
+	}
+	return &HashicorpVaultAuthDetails{AuthToken: String(v.AuthToken)}
+}
+
+func vaultSecretManager(sm *SecretManager) *VaultSecretManager {
+	return &VaultSecretManager{
+		ID:                  sm.ID,
+		Name:                sm.Name,
+		VaultURL:            sm.VaultURL,
+		BasePath:            sm.BasePath,
+		Namespace:           sm.Namespace,
+		AppRoleID:           sm.AppRoleID,
+		SecretEngineName:    sm.SecretEngineName,
+		SecretEngineVersion: sm.SecretEngineVersion,
+		RenewalInterval:     sm.SecretEngineRenewalInterval,
+		ReadOnly:            sm.IsReadOnly,
+		Default:             sm.IsDefault,
+		UsageScope:          sm.UsageScope,
+	}
+}
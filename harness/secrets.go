@@ -0,0 +1,80 @@
+package harness
+
+import (
+	"context"
+)
+
+// ListSecretsOptions narrows down the secrets returned by ListSecrets.
+type ListSecretsOptions struct {
+	ListOptions
+
+	// Types only keeps secrets of the given types, when set.
+	Types []SecretType
+
+	// SecretManagerIDs only keeps secrets stored in the given secret
+	// managers, when set.
+	SecretManagerIDs []string
+}
+
+func (o *ListSecretsOptions) filters() []*SecretFilter {
+	if o == nil {
+		return nil
+	}
+
+	var filters []*SecretFilter
+	if len(o.Types) > 0 {
+		filters = append(filters, &SecretFilter{
+			SecretType: &SecretTypeFilter{
+				Operator: EnumOperatorIn,
+				Values:   o.Types,
+			},
+		})
+	}
+	if len(o.SecretManagerIDs) > 0 {
+		filters = append(filters, &SecretFilter{
+			SecretManager: &IdFilter{
+				Operator: IdOperatorIn,
+				Values:   o.SecretManagerIDs,
+			},
+		})
+	}
+
+	return filters
+}
+
+// SecretIterator walks the secrets of the account, see ListSecrets.
+type SecretIterator struct {
+	pager
+	page []*Secret
+}
+
+// Secret returns the secret the iterator is positioned at.
+func (it *SecretIterator) Secret() *Secret {
+	return it.page[it.index]
+}
+
+// ListSecrets returns an iterator over the secrets of the account, fetching
+// them a page at a time as the iterator advances. Secret values are never
+// returned by Harness.io.
+func (h *Client) ListSecrets(opts *ListSecretsOptions) *SecretIterator {
+	var listOpts *ListOptions
+	if opts != nil {
+		listOpts = &opts.ListOptions
+	}
+	filters := opts.filters()
+
+	it := &SecretIterator{}
+	it.pager = newPager(listOpts.pageSize(), func(ctx context.Context, limit int, offset int) (int, *PageInfo, error) {
+		h.logger.Debugf("Listing Harness.io secrets from offset %d", offset)
+
+		conn, err := h.secrets(ctx, limit, Int(offset), filters)
+		if err != nil || conn == nil {
+			return 0, nil, err
+		}
+
+		it.page = conn.Nodes
+		return len(conn.Nodes), conn.PageInfo, nil
+	})
+
+	return it
+}
@@ -0,0 +1,75 @@
+package harness
+
+import "context"
+
+// GetService fetches a service of an application by its id.
+func (h *Client) GetService(ctx context.Context, appID string, id string) (*Service, error) {
+	h.logger.Debugf("Getting a Harness.io service with id '%s' in application '%s'", id, appID)
+
+	svc, err := h.service(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if svc == nil || svc.ApplicationID != appID {
+		return nil, newNotFoundError("service")
+	}
+
+	return svc, nil
+}
+
+func (h *Client) NewService(ctx context.Context, s *Service) (*Service, error) {
+	h.logger.Debugf("Creating a Harness.io service with name '%s' in application '%s'", s.Name, s.ApplicationID)
+
+	payload, err := h.createService(ctx, &CreateServiceInput{
+		ApplicationID:  s.ApplicationID,
+		Name:           s.Name,
+		Description:    String(s.Description),
+		DeploymentType: s.DeploymentType,
+		ArtifactType:   s.ArtifactType,
+		Tags:           tagInputs(s.Tags),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Service == nil {
+		return nil, newNotFoundError("service")
+	}
+
+	return payload.Service, nil
+}
+
+// UpdateService updates the name, description and tags of a service. Its
+// deployment and artifact types cannot be changed.
+func (h *Client) UpdateService(ctx context.Context, s *Service) (*Service, error) {
+	h.logger.Debugf("Updating a Harness.io service with id '%s' in application '%s'", s.ID, s.ApplicationID)
+
+	payload, err := h.updateService(ctx, &UpdateServiceInput{
+		ApplicationID: s.ApplicationID,
+		ServiceID:     s.ID,
+		Name:          String(s.Name),
+		Description:   String(s.Description),
+		Tags:          tagInputs(s.Tags),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Service == nil {
+		return nil, newNotFoundError("service")
+	}
+
+	return payload.Service, nil
+}
+
+func (h *Client) DeleteService(ctx context.Context, appID string, id string) error {
+	h.logger.Debugf("Deleting a Harness.io service with id '%s' in application '%s'", id, appID)
+
+	_, err := h.deleteService(ctx, &DeleteServiceInput{
+		ApplicationID: appID,
+		ServiceID:     id,
+	})
+
+	return err
+}
@@ -0,0 +1,177 @@
+package harness
+
+import "context"
+
+// SSHCredentialSecret is a secret holding the credentials Harness.io connects
+// to hosts with, authenticating either over SSH or with Kerberos. Harness.io
+// never returns the secrets it refers to.
+type SSHCredentialSecret struct {
+	ID                   string                  `json:"id"`
+	Name                 string                  `json:"name"`
+	AuthenticationScheme SSHAuthenticationScheme `json:"authenticationScheme"`
+	Port                 int                     `json:"port"`
+
+	// UserName and CredentialType are those of SSH authentication, which
+	// uses the key held in KeySecretID, or the password held in
+	// PasswordSecretID.
+	UserName           string            `json:"userName"`
+	CredentialType     SSHCredentialType `json:"sshCredentialType"`
+	KeySecretID        string            `json:"sshKeySecretFileId"`
+	PassphraseSecretID string            `json:"passphraseSecretId"`
+
+	// Principal and Realm are those of Kerberos authentication, which
+	// obtains its ticket granting ticket with the key tab file at
+	// KeyTabFilePath, or the password held in PasswordSecretID, when
+	// TGTGenerationUsing is set.
+	Principal          string             `json:"principal"`
+	Realm              string             `json:"realm"`
+	TGTGenerationUsing TGTGenerationUsing `json:"tgtGenerationUsing"`
+	KeyTabFilePath     string             `json:"keyTabFilePath"`
+
+	PasswordSecretID string      `json:"passwordSecretId"`
+	UsageScope       *UsageScope `json:"usageScope"`
+}
+
+func (h *Client) GetSSHCredential(ctx context.Context, id string) (*SSHCredentialSecret, error) {
+	h.logger.Debugf("Getting a Harness.io SSH credential with id '%s'", id)
+
+	secret, err := h.secret(ctx, id, SecretTypeSSHCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, newNotFoundError("SSH credential")
+	}
+
+	return sshCredential(secret), nil
+}
+
+func (h *Client) NewSSHCredential(ctx context.Context, c *SSHCredentialSecret) (*SSHCredentialSecret, error) {
+	h.logger.Debugf("Creating a Harness.io SSH credential with name '%s'", c.Name)
+
+	ssh, kerberos := sshAuthenticationInput(c)
+	payload, err := h.createSecret(ctx, &CreateSecretInput{
+		SecretType: SecretTypeSSHCredential,
+		SSHCredential: &SSHCredentialInput{
+			Name:                   c.Name,
+			AuthenticationScheme:   c.AuthenticationScheme,
+			SSHAuthentication:      ssh,
+			KerberosAuthentication: kerberos,
+			UsageScope:             usageScopeInput(c.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("SSH credential")
+	}
+
+	return sshCredential(payload.Secret), nil
+}
+
+// UpdateSSHCredential updates an SSH credential, replacing how it
+// authenticates with what c holds.
+func (h *Client) UpdateSSHCredential(ctx context.Context, c *SSHCredentialSecret) (*SSHCredentialSecret, error) {
+	h.logger.Debugf("Updating a Harness.io SSH credential with id '%s'", c.ID)
+
+	ssh, kerberos := sshAuthenticationInput(c)
+	payload, err := h.updateSecret(ctx, &UpdateSecretInput{
+		SecretID:   c.ID,
+		SecretType: SecretTypeSSHCredential,
+		SSHCredential: &UpdateSSHCredential{
+			Name:                   String(c.Name),
+			AuthenticationScheme:   c.AuthenticationScheme,
+			SSHAuthentication:      ssh,
+			KerberosAuthentication: kerberos,
+			UsageScope:             usageScopeInput(c.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("SSH credential")
+	}
+
+	return sshCredential(payload.Secret), nil
+}
+
+func (h *Client) DeleteSSHCredential(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io SSH credential with id '%s'", id)
+
+	_, err := h.deleteSecret(ctx, &DeleteSecretInput{
+		SecretID:   id,
+		SecretType: SecretTypeSSHCredential,
+	})
+
+	return err
+}
+
+// sshAuthenticationInput returns the input of the authentication scheme of
+// c, leaving the other one nil.
+func sshAuthenticationInput(c *SSHCredentialSecret) (*SSHAuthenticationInput, *KerberosAuthenticationInput) {
+	if c.AuthenticationScheme == SSHAuthenticationSchemeKerberos {
+		kerberos := &KerberosAuthenticationInput{
+			Port:      c.Port,
+			Principal: c.Principal,
+			Realm:     c.Realm,
+		}
+
+		switch c.TGTGenerationUsing {
+		case TGTGenerationUsingKeyTabFile:
+			kerberos.TgtGenerationMethod = &TGTGenerationMethod{
+				TgtGenerationUsing: c.TGTGenerationUsing,
+				KeyTabFile:         &KeyTabFile{FilePath: c.KeyTabFilePath},
+			}
+		case TGTGenerationUsingPassword:
+			kerberos.TgtGenerationMethod = &TGTGenerationMethod{
+				TgtGenerationUsing: c.TGTGenerationUsing,
+				KerberosPassword:   &KerberosPassword{PasswordSecretID: c.PasswordSecretID},
+			}
+		}
+
+		return nil, kerberos
+	}
+
+	method := &SSHAuthenticationMethod{SSHCredentialType: c.CredentialType}
+	if c.CredentialType == SSHCredentialTypePassword {
+		method.ServerPassword = &SSHPassword{PasswordSecretID: c.PasswordSecretID}
+	} else {
+		method.InlineSSHKey = &InlineSSHKey{
+			SSHKeySecretFileID: c.KeySecretID,
+			PassphraseSecretID: optionalString(c.PassphraseSecretID),
+		}
+	}
+
+	return &SSHAuthenticationInput{
+		Port:                    c.Port,
+		UserName:                c.UserName,
+		SSHAuthenticationMethod: method,
+	}, nil
+}
+
+func sshCredential(s *Secret) *SSHCredentialSecret {
+	c := &SSHCredentialSecret{
+		ID:         s.ID,
+		Name:       s.Name,
+		UsageScope: s.UsageScope,
+	}
+
+	if auth := s.AuthenticationType; auth != nil {
+		c.Port = auth.Port
+		if auth.Typename == "KerberosAuthentication" {
+			c.AuthenticationScheme = SSHAuthenticationSchemeKerberos
+			c.Principal = auth.Principal
+			c.Realm = auth.Realm
+		} else {
+			c.AuthenticationScheme = SSHAuthenticationSchemeSSH
+			c.UserName = auth.UserName
+		}
+	}
+
+	return c
+}
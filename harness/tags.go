@@ -0,0 +1,16 @@
+package harness
+
+// tagInputs converts the tags of an entity into the input used to write
+// them. It always returns a list, so that an entity without tags has any
+// tags it had cleared.
+func tagInputs(tags []*Tag) []*TagInput {
+	inputs := make([]*TagInput, 0, len(tags))
+	for _, tag := range tags {
+		input := &TagInput{Name: tag.Name}
+		if tag.Value != "" {
+			input.Value = String(tag.Value)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
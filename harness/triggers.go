@@ -0,0 +1,217 @@
+package harness
+
+import "context"
+
+// conditionTypes maps the type of condition Harness.io returns to the one
+// expected when writing a trigger.
+var conditionTypes = map[TriggerConditionType]ConditionType{
+	TriggerConditionTypeNewArtifact:        ConditionTypeOnNewArtifact,
+	TriggerConditionTypePipelineCompletion: ConditionTypeOnPipelineCompletion,
+	TriggerConditionTypeScheduled:          ConditionTypeOnSchedule,
+	TriggerConditionTypeWebhook:            ConditionTypeOnWebhook,
+}
+
+// artifactSelectionTypenames maps each type of artifact selection to the
+// GraphQL type Harness.io returns it as.
+var artifactSelectionTypenames = map[ArtifactSelectionType]string{
+	ArtifactSelectionTypeFromTriggeringArtifact: "FromTriggeringArtifactSource",
+	ArtifactSelectionTypeFromTriggeringPipeline: "FromTriggeringPipeline",
+	ArtifactSelectionTypeFromPayloadSource:      "FromWebhookPayload",
+	ArtifactSelectionTypeLastCollected:          "LastCollected",
+	ArtifactSelectionTypeLastDeployedWorkflow:   "LastDeployedFromWorkflow",
+	ArtifactSelectionTypeLastDeployedPipeline:   "LastDeployedFromPipeline",
+}
+
+// NewArtifactSelection returns an artifact selection of the given type for a
+// service, to be completed with the fields of that type.
+func NewArtifactSelection(selectionType ArtifactSelectionType, serviceID string) *ArtifactSelection {
+	return &ArtifactSelection{
+		Typename:  artifactSelectionTypenames[selectionType],
+		ServiceID: serviceID,
+	}
+}
+
+// SelectionType returns the type of the artifact selection.
+func (a *ArtifactSelection) SelectionType() ArtifactSelectionType {
+	for selectionType, typename := range artifactSelectionTypenames {
+		if typename == a.Typename {
+			return selectionType
+		}
+	}
+	return ""
+}
+
+// GetTrigger fetches a trigger of an application by its id.
+func (h *Client) GetTrigger(ctx context.Context, appID string, id string) (*Trigger, error) {
+	h.logger.Debugf("Getting a Harness.io trigger with id '%s' in application '%s'", id, appID)
+
+	trigger, err := h.trigger(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if trigger == nil || trigger.ApplicationID != appID {
+		return nil, newNotFoundError("trigger")
+	}
+
+	return trigger, nil
+}
+
+func (h *Client) NewTrigger(ctx context.Context, t *Trigger) (*Trigger, error) {
+	h.logger.Debugf("Creating a Harness.io trigger with name '%s' in application '%s'", t.Name, t.ApplicationID)
+
+	payload, err := h.createTrigger(ctx, &CreateTriggerInput{
+		ApplicationID: t.ApplicationID,
+		Name:          t.Name,
+		Description:   String(t.Description),
+		Condition:     triggerConditionInput(t.Condition),
+		Action:        triggerActionInput(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Trigger == nil {
+		return nil, newNotFoundError("trigger")
+	}
+
+	return payload.Trigger, nil
+}
+
+// UpdateTrigger replaces the name, description, condition and action of a
+// trigger.
+func (h *Client) UpdateTrigger(ctx context.Context, t *Trigger) (*Trigger, error) {
+	h.logger.Debugf("Updating a Harness.io trigger with id '%s' in application '%s'", t.ID, t.ApplicationID)
+
+	payload, err := h.updateTrigger(ctx, &UpdateTriggerInput{
+		ApplicationID: t.ApplicationID,
+		TriggerID:     t.ID,
+		Name:          t.Name,
+		Description:   String(t.Description),
+		Condition:     triggerConditionInput(t.Condition),
+		Action:        triggerActionInput(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Trigger == nil {
+		return nil, newNotFoundError("trigger")
+	}
+
+	return payload.Trigger, nil
+}
+
+func (h *Client) DeleteTrigger(ctx context.Context, appID string, id string) error {
+	h.logger.Debugf("Deleting a Harness.io trigger with id '%s' in application '%s'", id, appID)
+
+	_, err := h.deleteTrigger(ctx, &DeleteTriggerInput{
+		ApplicationID: appID,
+		TriggerID:     id,
+	})
+
+	return err
+}
+
+// triggerConditionInput converts the condition of a trigger into the input
+// of its condition type.
+func triggerConditionInput(c *TriggerCondition) *TriggerConditionInput {
+	if c == nil {
+		return nil
+	}
+
+	input := &TriggerConditionInput{ConditionType: conditionTypes[c.TriggerConditionType]}
+
+	switch c.TriggerConditionType {
+	case TriggerConditionTypeNewArtifact:
+		input.ArtifactConditionInput = &ArtifactConditionInput{
+			ArtifactSourceID: c.ArtifactSourceID,
+			ArtifactFilter:   optionalString(c.ArtifactFilter),
+			Regex:            Bool(c.Regex),
+		}
+	case TriggerConditionTypePipelineCompletion:
+		input.PipelineConditionInput = &PipelineConditionInput{
+			PipelineID: c.PipelineID,
+		}
+	case TriggerConditionTypeScheduled:
+		input.ScheduleConditionInput = &ScheduleConditionInput{
+			CronExpression:    c.CronExpression,
+			OnNewArtifactOnly: Bool(c.OnNewArtifactOnly),
+		}
+	case TriggerConditionTypeWebhook:
+		input.WebhookConditionInput = webhookConditionInput(c)
+	}
+
+	return input
+}
+
+// webhookConditionInput converts the event of a webhook condition into the
+// field of its source, custom webhooks having no event.
+func webhookConditionInput(c *TriggerCondition) *WebhookConditionInput {
+	input := &WebhookConditionInput{
+		WebhookSourceType: c.WebhookSource,
+		BranchRegex:       optionalString(c.BranchRegex),
+	}
+
+	event := c.WebhookEvent
+	if event == nil || event.Event == "" {
+		return input
+	}
+
+	switch c.WebhookSource {
+	case WebhookSourceGithub:
+		input.GithubEvent = &GitHubEventInput{
+			Event:  GitHubEventType(event.Event),
+			Action: GitHubAction(event.Action),
+		}
+	case WebhookSourceGitlab:
+		input.GitlabEvent = GitlabEvent(event.Event)
+	case WebhookSourceBitbucket:
+		input.BitbucketEvent = BitbucketEvent(event.Event)
+	}
+
+	return input
+}
+
+// triggerActionInput converts the action of a trigger into the input
+// executing its workflow or pipeline, always returning lists so that
+// selections and variables that were removed are cleared.
+func triggerActionInput(t *Trigger) *TriggerActionInput {
+	a := t.Action
+	if a == nil {
+		return nil
+	}
+
+	input := &TriggerActionInput{
+		ExecutionType:                ExecutionTypeWorkflow,
+		EntityID:                     a.WorkflowID,
+		ArtifactSelections:           make([]*ArtifactSelectionInput, 0, len(a.ArtifactSelections)),
+		Variables:                    make([]*TriggerVariableValueInput, 0, len(a.Variables)),
+		ExcludeHostsWithSameArtifact: Bool(t.ExcludeHostsWithSameArtifact),
+	}
+	if a.PipelineID != "" {
+		input.ExecutionType = ExecutionTypePipeline
+		input.EntityID = a.PipelineID
+	}
+
+	for _, s := range a.ArtifactSelections {
+		input.ArtifactSelections = append(input.ArtifactSelections, &ArtifactSelectionInput{
+			ServiceID:             s.ServiceID,
+			ArtifactSelectionType: s.SelectionType(),
+			ArtifactSourceID:      optionalString(s.ArtifactSourceID),
+			ArtifactFilter:        optionalString(s.ArtifactFilter),
+			Regex:                 Bool(s.Regex),
+			WorkflowID:            optionalString(s.WorkflowID),
+			PipelineID:            optionalString(s.PipelineID),
+		})
+	}
+
+	for _, v := range a.Variables {
+		input.Variables = append(input.Variables, &TriggerVariableValueInput{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+
+	return input
+}
@@ -0,0 +1,38 @@
+package harness
+
+// usageScopeInput converts a usage scope read from Harness.io into the input
+// used to write it back.
+func usageScopeInput(u *UsageScope) *UsageScopeInput {
+	if u == nil {
+		return nil
+	}
+
+	input := &UsageScopeInput{
+		AppEnvScopes: make([]*AppEnvScopeInput, 0, len(u.AppEnvScopes)),
+	}
+
+	for _, scope := range u.AppEnvScopes {
+		appEnvScope := &AppEnvScopeInput{
+			Application: &AppScopeFilterInput{},
+			Environment: &EnvScopeFilterInput{},
+		}
+
+		if scope.Application != nil {
+			appEnvScope.Application.FilterType = scope.Application.FilterType
+			if scope.Application.AppID != "" {
+				appEnvScope.Application.AppID = String(scope.Application.AppID)
+			}
+		}
+
+		if scope.Environment != nil {
+			appEnvScope.Environment.FilterType = scope.Environment.FilterType
+			if scope.Environment.EnvID != "" {
+				appEnvScope.Environment.EnvID = String(scope.Environment.EnvID)
+			}
+		}
+
+		input.AppEnvScopes = append(input.AppEnvScopes, appEnvScope)
+	}
+
+	return input
+}
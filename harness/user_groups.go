@@ -0,0 +1,148 @@
+package harness
+
+import "context"
+
+func (h *Client) GetUserGroup(ctx context.Context, id string) (*UserGroup, error) {
+	h.logger.Debugf("Getting a Harness.io user group with id '%s'", id)
+
+	group, err := h.userGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if group == nil {
+		return nil, newNotFoundError("user group")
+	}
+
+	return group, nil
+}
+
+func (h *Client) GetUserGroupByName(ctx context.Context, name string) (*UserGroup, error) {
+	h.logger.Debugf("Getting a Harness.io user group with name '%s'", name)
+
+	group, err := h.userGroupByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if group == nil {
+		return nil, newNotFoundError("user group")
+	}
+
+	return group, nil
+}
+
+func (h *Client) NewUserGroup(ctx context.Context, g *UserGroup) (*UserGroup, error) {
+	h.logger.Debugf("Creating a Harness.io user group with name '%s'", g.Name)
+
+	payload, err := h.createUserGroup(ctx, &CreateUserGroupInput{
+		Name:                 g.Name,
+		Description:          String(g.Description),
+		Permissions:          userGroupPermissionsInput(g.Permissions),
+		NotificationSettings: notificationSettingsInput(g.NotificationSettings),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.UserGroup == nil {
+		return nil, newNotFoundError("user group")
+	}
+
+	return payload.UserGroup, nil
+}
+
+// UpdateUserGroup replaces the permissions and notification settings of a
+// user group, along with its name and description.
+func (h *Client) UpdateUserGroup(ctx context.Context, g *UserGroup) (*UserGroup, error) {
+	h.logger.Debugf("Updating a Harness.io user group with id '%s'", g.ID)
+
+	payload, err := h.updateUserGroup(ctx, &UpdateUserGroupInput{
+		UserGroupID:          g.ID,
+		Name:                 String(g.Name),
+		Description:          String(g.Description),
+		Permissions:          userGroupPermissionsInput(g.Permissions),
+		NotificationSettings: notificationSettingsInput(g.NotificationSettings),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.UserGroup == nil {
+		return nil, newNotFoundError("user group")
+	}
+
+	return payload.UserGroup, nil
+}
+
+func (h *Client) DeleteUserGroup(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io user group with id '%s'", id)
+
+	_, err := h.deleteUserGroup(ctx, &DeleteUserGroupInput{
+		UserGroupID: id,
+	})
+
+	return err
+}
+
+// userGroupPermissionsInput converts the permissions of a user group into
+// the input used to write them, always returning lists so that permissions
+// that were removed are revoked.
+func userGroupPermissionsInput(p *UserGroupPermissions) *UserGroupPermissionsInput {
+	input := &UserGroupPermissionsInput{
+		AccountPermissions: &AccountPermissionInput{
+			AccountPermissionTypes: []AccountPermissionType{},
+		},
+		AppPermissions: []*ApplicationPermissionInput{},
+	}
+	if p == nil {
+		return input
+	}
+
+	if p.AccountPermissions != nil && p.AccountPermissions.AccountPermissionTypes != nil {
+		input.AccountPermissions.AccountPermissionTypes = p.AccountPermissions.AccountPermissionTypes
+	}
+
+	for _, permission := range p.AppPermissions {
+		applications := &AppFilterInput{}
+		if permission.Applications != nil {
+			applications.FilterType = permission.Applications.FilterType
+			applications.AppIDs = permission.Applications.AppIDs
+		}
+
+		input.AppPermissions = append(input.AppPermissions, &ApplicationPermissionInput{
+			PermissionType: permission.PermissionType,
+			Applications:   applications,
+			Actions:        permission.Actions,
+		})
+	}
+
+	return input
+}
+
+// notificationSettingsInput converts the notification settings of a user
+// group into the input used to write them.
+func notificationSettingsInput(n *NotificationSettings) *NotificationSettingsInput {
+	input := &NotificationSettingsInput{
+		SendNotificationToMembers: Bool(false),
+		SendMailToNewMembers:      Bool(false),
+		GroupEmailAddresses:       []string{},
+		SlackNotificationSetting:  &SlackNotificationSettingInput{},
+	}
+	if n == nil {
+		return input
+	}
+
+	input.SendNotificationToMembers = Bool(n.SendNotificationToMembers)
+	input.SendMailToNewMembers = Bool(n.SendMailToNewMembers)
+	if n.GroupEmailAddresses != nil {
+		input.GroupEmailAddresses = n.GroupEmailAddresses
+	}
+	if slack := n.SlackNotificationSetting; slack != nil {
+		input.SlackNotificationSetting.SlackChannelName = optionalString(slack.SlackChannelName)
+		input.SlackNotificationSetting.SlackWebhookURL = optionalString(slack.SlackWebhookURL)
+	}
+	input.PagerDutyIntegrationKeySecretID = optionalString(n.PagerDutyIntegrationKeySecretID)
+
+	return input
+}
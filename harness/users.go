@@ -0,0 +1,184 @@
+package harness
+
+import "context"
+
+// ListUsersOptions narrows down the users returned by ListUsers.
+type ListUsersOptions struct {
+	ListOptions
+
+	// UserGroupIDs only keeps members of the given user groups, when set.
+	UserGroupIDs []string
+}
+
+func (o *ListUsersOptions) filters() []*UserFilter {
+	if o == nil {
+		return nil
+	}
+
+	var filters []*UserFilter
+	if len(o.UserGroupIDs) > 0 {
+		filters = append(filters, &UserFilter{
+			UserGroup: &IdFilter{
+				Operator: IdOperatorIn,
+				Values:   o.UserGroupIDs,
+			},
+		})
+	}
+
+	return filters
+}
+
+// UserIterator walks the users of the account, see ListUsers.
+type UserIterator struct {
+	pager
+	page []*User
+}
+
+// User returns the user the iterator is positioned at.
+func (it *UserIterator) User() *User {
+	return it.page[it.index]
+}
+
+// ListUsers returns an iterator over the users of the account, fetching them
+// a page at a time as the iterator advances.
+func (h *Client) ListUsers(opts *ListUsersOptions) *UserIterator {
+	var listOpts *ListOptions
+	if opts != nil {
+		listOpts = &opts.ListOptions
+	}
+	filters := opts.filters()
+
+	it := &UserIterator{}
+	it.pager = newPager(listOpts.pageSize(), func(ctx context.Context, limit int, offset int) (int, *PageInfo, error) {
+		h.logger.Debugf("Listing Harness.io users from offset %d", offset)
+
+		conn, err := h.users(ctx, limit, Int(offset), filters)
+		if err != nil || conn == nil {
+			return 0, nil, err
+		}
+
+		it.page = conn.Nodes
+		return len(conn.Nodes), conn.PageInfo, nil
+	})
+
+	return it
+}
+
+func (h *Client) GetUser(ctx context.Context, id string) (*User, error) {
+	h.logger.Debugf("Getting a Harness.io user with id '%s'", id)
+
+	user, err := h.user(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, newNotFoundError("user")
+	}
+
+	return user, nil
+}
+
+func (h *Client) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	h.logger.Debugf("Getting a Harness.io user with email '%s'", email)
+
+	user, err := h.userByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, newNotFoundError("user")
+	}
+
+	return user, nil
+}
+
+// InviteUser invites a user to the account by email, making them a member of
+// the given user groups. The user stays pending until they accept the
+// invitation.
+func (h *Client) InviteUser(ctx context.Context, u *User) (*User, error) {
+	h.logger.Debugf("Inviting '%s' to Harness.io", u.Email)
+
+	payload, err := h.createUser(ctx, &CreateUserInput{
+		Name:         u.Name,
+		Email:        u.Email,
+		UserGroupIDs: u.UserGroupIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.User == nil {
+		return nil, newNotFoundError("user")
+	}
+
+	return payload.User, nil
+}
+
+// UpdateUser updates the name of a user and replaces the user groups they
+// are a member of, unless UserGroupIDs is nil. An empty list removes them from
+// every group.
+func (h *Client) UpdateUser(ctx context.Context, u *User) (*User, error) {
+	h.logger.Debugf("Updating a Harness.io user with id '%s'", u.ID)
+
+	payload, err := h.updateUser(ctx, &UpdateUserInput{
+		ID:           u.ID,
+		Name:         String(u.Name),
+		UserGroupIDs: u.UserGroupIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.User == nil {
+		return nil, newNotFoundError("user")
+	}
+
+	return payload.User, nil
+}
+
+// DeleteUser removes a user from the account.
+func (h *Client) DeleteUser(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io user with id '%s'", id)
+
+	_, err := h.deleteUser(ctx, &DeleteUserInput{
+		ID: id,
+	})
+
+	return err
+}
+
+func (h *Client) AddUserToUserGroup(ctx context.Context, userID string, userGroupID string) error {
+	h.logger.Debugf("Adding Harness.io user '%s' to user group '%s'", userID, userGroupID)
+
+	_, err := h.addUserToGroup(ctx, &AddUserToUserGroupInput{
+		UserID:      userID,
+		UserGroupID: userGroupID,
+	})
+
+	return err
+}
+
+func (h *Client) RemoveUserFromUserGroup(ctx context.Context, userID string, userGroupID string) error {
+	h.logger.Debugf("Removing Harness.io user '%s' from user group '%s'", userID, userGroupID)
+
+	_, err := h.removeUserFromGroup(ctx, &RemoveUserFromUserGroupInput{
+		UserID:      userID,
+		UserGroupID: userGroupID,
+	})
+
+	return err
+}
+
+// GetUserGroupMembers returns the ids of the members of a user group.
+func (h *Client) GetUserGroupMembers(ctx context.Context, userGroupID string) ([]string, error) {
+	it := h.ListUsers(&ListUsersOptions{UserGroupIDs: []string{userGroupID}})
+
+	ids := []string{}
+	for it.Next(ctx) {
+		ids = append(ids, it.User().ID)
+	}
+
+	return ids, it.Err()
+}
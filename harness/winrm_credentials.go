@@ -0,0 +1,114 @@
+package harness
+
+import "context"
+
+// WinRMCredentialSecret is a secret holding the NTLM credentials Harness.io
+// connects to Windows hosts with. Harness.io never returns the secret holding
+// the password.
+type WinRMCredentialSecret struct {
+	ID               string      `json:"id"`
+	Name             string      `json:"name"`
+	Domain           string      `json:"domain"`
+	UserName         string      `json:"userName"`
+	PasswordSecretID string      `json:"passwordSecretId"`
+	UseSSL           bool        `json:"useSSL"`
+	SkipCertCheck    bool        `json:"skipCertCheck"`
+	Port             int         `json:"port"`
+	UsageScope       *UsageScope `json:"usageScope"`
+}
+
+func (h *Client) GetWinRMCredential(ctx context.Context, id string) (*WinRMCredentialSecret, error) {
+	h.logger.Debugf("Getting a Harness.io WinRM credential with id '%s'", id)
+
+	secret, err := h.secret(ctx, id, SecretTypeWinRMCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == nil {
+		return nil, newNotFoundError("WinRM credential")
+	}
+
+	return winRMCredential(secret), nil
+}
+
+func (h *Client) NewWinRMCredential(ctx context.Context, c *WinRMCredentialSecret) (*WinRMCredentialSecret, error) {
+	h.logger.Debugf("Creating a Harness.io WinRM credential with name '%s'", c.Name)
+
+	payload, err := h.createSecret(ctx, &CreateSecretInput{
+		SecretType: SecretTypeWinRMCredential,
+		WinRMCredential: &WinRMCredentialInput{
+			Name:                 c.Name,
+			AuthenticationScheme: WinRMAuthenticationSchemeNtlm,
+			Domain:               String(c.Domain),
+			UserName:             c.UserName,
+			PasswordSecretID:     c.PasswordSecretID,
+			UseSSL:               Bool(c.UseSSL),
+			SkipCertCheck:        Bool(c.SkipCertCheck),
+			Port:                 Int(c.Port),
+			UsageScope:           usageScopeInput(c.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("WinRM credential")
+	}
+
+	return winRMCredential(payload.Secret), nil
+}
+
+func (h *Client) UpdateWinRMCredential(ctx context.Context, c *WinRMCredentialSecret) (*WinRMCredentialSecret, error) {
+	h.logger.Debugf("Updating a Harness.io WinRM credential with id '%s'", c.ID)
+
+	payload, err := h.updateSecret(ctx, &UpdateSecretInput{
+		SecretID:   c.ID,
+		SecretType: SecretTypeWinRMCredential,
+		WinRMCredential: &UpdateWinRMCredential{
+			Name:                 String(c.Name),
+			AuthenticationScheme: WinRMAuthenticationSchemeNtlm,
+			Domain:               String(c.Domain),
+			UserName:             String(c.UserName),
+			PasswordSecretID:     String(c.PasswordSecretID),
+			UseSSL:               Bool(c.UseSSL),
+			SkipCertCheck:        Bool(c.SkipCertCheck),
+			Port:                 Int(c.Port),
+			UsageScope:           usageScopeInput(c.UsageScope),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payload == nil || payload.Secret == nil {
+		return nil, newNotFoundError("WinRM credential")
+	}
+
+	return winRMCredential(payload.Secret), nil
+}
+
+func (h *Client) DeleteWinRMCredential(ctx context.Context, id string) error {
+	h.logger.Debugf("Deleting a Harness.io WinRM credential with id '%s'", id)
+
+	_, err := h.deleteSecret(ctx, &DeleteSecretInput{
+		SecretID:   id,
+		SecretType: SecretTypeWinRMCredential,
+	})
+
+	return err
+}
+
+func winRMCredential(s *Secret) *WinRMCredentialSecret {
+	return &WinRMCredentialSecret{
+		ID:            s.ID,
+		Name:          s.Name,
+		Domain:        s.Domain,
+		UserName:      s.UserName,
+		UseSSL:        s.UseSSL,
+		SkipCertCheck: s.SkipCertCheck,
+		Port:          s.Port,
+		UsageScope:    s.UsageScope,
+	}
+}
@@ -0,0 +1,108 @@
+package harness
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// YAMLClient manages Harness.io entities through their config-as-code YAML,
+// which covers entities such as workflows, pipelines and templates that have
+// no GraphQL mutations. Paths are those of the Harness.io YAML tree, such as
+// Setup/Applications/my-app/Workflows/deploy.yaml.
+type YAMLClient struct {
+	h *Client
+}
+
+// YAML returns the config-as-code client of the account, sharing the
+// authentication, limits and retries of h.
+func (h *Client) YAML() *YAMLClient {
+	return &YAMLClient{h: h}
+}
+
+// fileOperationStatus is what Harness.io reports for each file it upserted.
+type fileOperationStatus struct {
+	YAMLFilePath string `json:"yamlFilePath"`
+	Status       string `json:"status"`
+	ErrorMessage string `json:"errorMssg"`
+}
+
+type yamlContent struct {
+	YAMLFilePath string `json:"yamlFilePath"`
+	YAML         string `json:"yaml"`
+}
+
+// Upsert creates the entity described by content at path, or replaces it.
+func (y *YAMLClient) Upsert(ctx context.Context, path string, content string) error {
+	y.h.logger.Debugf("Upserting Harness.io YAML at '%s'", path)
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	if err := form.WriteField("yamlContent", content); err != nil {
+		return err
+	}
+	if err := form.Close(); err != nil {
+		return err
+	}
+
+	status := &fileOperationStatus{}
+	err := y.h.rest(ctx, &restRequest{
+		name:        "upsertYaml",
+		method:      http.MethodPost,
+		path:        "/setup-as-code/yaml/upsert-entity",
+		params:      url.Values{"yamlFilePath": {path}},
+		body:        body.Bytes(),
+		contentType: form.FormDataContentType(),
+	}, status)
+	if err != nil {
+		return err
+	}
+
+	if status.Status == "FAILED" {
+		return &APIError{
+			Kind:       ErrValidation,
+			StatusCode: http.StatusOK,
+			Operation:  "upsertYaml",
+			Message:    fmt.Sprintf("%s: %s", path, status.ErrorMessage),
+		}
+	}
+
+	return nil
+}
+
+// Get returns the YAML of the entity at path.
+func (y *YAMLClient) Get(ctx context.Context, path string) (string, error) {
+	y.h.logger.Debugf("Getting Harness.io YAML at '%s'", path)
+
+	content := &yamlContent{}
+	err := y.h.rest(ctx, &restRequest{
+		name:   "getYaml",
+		method: http.MethodGet,
+		path:   "/setup-as-code/yaml/yaml-content",
+		params: url.Values{"yamlFilePath": {path}},
+	}, content)
+	if err != nil {
+		return "", err
+	}
+
+	if content.YAML == "" {
+		return "", newNotFoundError("YAML file")
+	}
+
+	return content.YAML, nil
+}
+
+// Delete deletes the entity at path.
+func (y *YAMLClient) Delete(ctx context.Context, path string) error {
+	y.h.logger.Debugf("Deleting Harness.io YAML at '%s'", path)
+
+	return y.h.rest(ctx, &restRequest{
+		name:   "deleteYaml",
+		method: http.MethodDelete,
+		path:   "/setup-as-code/yaml/delete-entities",
+		params: url.Values{"filePaths": {path}},
+	}, nil)
+}
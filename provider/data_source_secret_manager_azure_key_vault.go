@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSecretManagerAzureKeyVault() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vault_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subscription": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+		ReadContext: dataSourceSecretManagerAzureKeyVaultRead,
+	}
+}
+
+func dataSourceSecretManagerAzureKeyVaultRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.GetAzureKeyVaultSecretManagerByName(c, d.Get("name").(string))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read Azure Key Vault secret manager", azureKeyVaultSecretManagerFields)
+	}
+
+	d.SetId(sm.ID)
+	flattenAzureKeyVaultSecretManager(d, sm)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// harnessDiagnostics turns an error returned by the Harness client into a
+// diagnostic. fields maps Harness input field names to the attributes they
+// are configured from, so that rejected input is reported against the right
+// attribute.
+func harnessDiagnostics(err error, summary string, fields map[string]string) diag.Diagnostics {
+	var apiError *Harness.APIError
+	if !errors.As(err, &apiError) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   err.Error(),
+		}}
+	}
+
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   fmt.Sprintf("%s\n\n%s", errorHint(apiError), apiError.Message),
+	}
+
+	if attribute, ok := fields[apiError.Field]; ok {
+		d.AttributePath = cty.GetAttrPath(attribute)
+	}
+
+	return diag.Diagnostics{d}
+}
+
+// errorHint explains the kind of apiError, naming the credentials the provider
+// is configured with when Harness.io rejected them.
+func errorHint(apiError *Harness.APIError) string {
+	credentials := apiError.Credentials
+	if credentials == "" {
+		credentials = "credentials"
+	}
+
+	switch apiError.Kind {
+	case Harness.ErrNotFound:
+		return "The entity does not exist in Harness.io."
+	case Harness.ErrUnauthorized:
+		return fmt.Sprintf("Harness.io did not accept the %s. Check the %s and account_id.", credentials, credentials)
+	case Harness.ErrForbidden:
+		return fmt.Sprintf("Harness.io does not allow the %s to perform this operation.", credentials)
+	case Harness.ErrConflict:
+		return "An entity with the same name already exists."
+	case Harness.ErrValidation:
+		return "Harness.io rejected the configuration."
+	case Harness.ErrRateLimited:
+		return "Harness.io kept throttling requests after several retries."
+	case Harness.ErrServerError:
+		return "Harness.io failed to process the request."
+	}
+	return "Harness.io returned an error."
+}
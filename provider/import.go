@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// importAppEntity imports an entity belonging to an application, such as a
+// service, from an id of the form app_id/entity_id.
+func importAppEntity(c context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import id %q, expected app_id/id", d.Id())
+	}
+
+	d.Set("app_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// importSecretManager imports a secret manager from its id or, when no secret
+// manager has that id, from its name.
+func importSecretManager(c context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Harness.Client)
+
+	_, err := client.GetSecretManager(c, d.Id())
+	if err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+	if !errors.Is(err, Harness.ErrNotFound) {
+		return nil, err
+	}
+
+	sm, err := client.GetSecretManagerByName(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		return nil, fmt.Errorf("no secret manager has the id or name %q", d.Id())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(sm.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
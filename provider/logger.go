@@ -0,0 +1,15 @@
+package provider
+
+import "log"
+
+// terraformLogger hands the logs of the Harness client to Terraform, which
+// shows them with TF_LOG=DEBUG or TF_LOG=TRACE.
+type terraformLogger struct{}
+
+func (terraformLogger) Debugf(format string, args ...interface{}) {
+	log.Printf("[DEBUG] "+format, args...)
+}
+
+func (terraformLogger) Tracef(format string, args ...interface{}) {
+	log.Printf("[TRACE] "+format, args...)
+}
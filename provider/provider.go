@@ -3,23 +3,66 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	Harness "github.com/eu-evops/terraform-provider-harness/harness"
 )
 
+// Reads of resources refreshed together are sent to Harness.io in batches of
+// up to maxBatchSize, collected over batchWindow.
+const (
+	batchWindow  = 10 * time.Millisecond
+	maxBatchSize = 25
+)
+
+// authMethods are the attributes of the auth block, of which exactly one
+// must be set.
+var authMethods = []string{"auth.0.api_key", "auth.0.bearer_token", "auth.0.token_file"}
+
 // Provider for Harness.io
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_key": {
 				Type:        schema.TypeString,
-				Description: "Your Harness.io API key",
-				Required:    true,
+				Description: "Your Harness.io API key, unless authenticating with an auth block",
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("HARNESS_API_KEY", nil),
 			},
+			"auth": {
+				Type:        schema.TypeList,
+				Description: "How to authenticate with Harness.io, taking precedence over api_key",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_key": {
+							Type:         schema.TypeString,
+							Description:  "A Harness.io API key",
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: authMethods,
+						},
+						"bearer_token": {
+							Type:         schema.TypeString,
+							Description:  "A bearer token, such as a JWT issued by Harness.io",
+							Optional:     true,
+							Sensitive:    true,
+							ExactlyOneOf: authMethods,
+						},
+						"token_file": {
+							Type:         schema.TypeString,
+							Description:  "The path of a file holding a bearer token, read again for every request",
+							Optional:     true,
+							ExactlyOneOf: authMethods,
+						},
+					},
+				},
+			},
 			"account_id": {
 				Type:        schema.TypeString,
 				Description: "Your Harness.io account id",
@@ -33,24 +76,91 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("HARNESS_ENDPOINT", nil),
 				Default:     "https://app.harness.io",
 			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Description:  "The most requests per second sent to Harness.io, 0 for no limit",
+				Optional:     true,
+				Default:      10.0,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Description:  "The most requests in flight to Harness.io at once, 0 for no limit",
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"harness_application":               resourceApplication(),
-			"harness_cloud_provider_azure":      resourceCloudProviderAzure(),
-			"harness_cloud_provider_kubernetes": resourceCloudProviderKubernetes(),
-			"harness_encrypted_secret":          resourceEncryptedSecret(),
+			"harness_application":                        resourceApplication(),
+			"harness_cloud_provider_aws":                 resourceCloudProviderAws(),
+			"harness_cloud_provider_azure":               resourceCloudProviderAzure(),
+			"harness_cloud_provider_gcp":                 resourceCloudProviderGcp(),
+			"harness_cloud_provider_kubernetes":          resourceCloudProviderKubernetes(),
+			"harness_encrypted_file":                     resourceEncryptedFile(),
+			"harness_encrypted_secret":                   resourceEncryptedSecret(),
+			"harness_environment":                        resourceEnvironment(),
+			"harness_infrastructure_definition":          resourceInfrastructureDefinition(),
+			"harness_secret_manager_aws_kms":             resourceSecretManagerAWSKMS(),
+			"harness_secret_manager_aws_secrets_manager": resourceSecretManagerAWSSecretsManager(),
+			"harness_secret_manager_azure_key_vault":     resourceSecretManagerAzureKeyVault(),
+			"harness_secret_manager_gcp_kms":             resourceSecretManagerGCPKMS(),
+			"harness_secret_manager_gcp_secrets_manager": resourceSecretManagerGCPSecretsManager(),
+			"harness_secret_manager_vault":               resourceSecretManagerVault(),
+			"harness_service":                            resourceService(),
+			"harness_ssh_credential":                     resourceSSHCredential(),
+			"harness_trigger":                            resourceTrigger(),
+			"harness_user":                               resourceUser(),
+			"harness_user_group":                         resourceUserGroup(),
+			"harness_user_group_membership":              resourceUserGroupMembership(),
+			"harness_winrm_credential":                   resourceWinRMCredential(),
+			"harness_yaml_config":                        resourceYAMLConfig(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"harness_secret_manager_azure_key_vault": dataSourceSecretManagerAzureKeyVault(),
 		},
-		DataSourcesMap:       map[string]*schema.Resource{},
 		ConfigureContextFunc: configureFunc,
 	}
 }
 
 func configureFunc(c context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	apiKey := d.Get("api_key").(string)
+	auth, err := authenticator(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	endpoint := d.Get("endpoint").(string)
 	accountID := d.Get("account_id").(string)
+	maxRequestsPerSecond := d.Get("max_requests_per_second").(float64)
+	maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
 
 	url := fmt.Sprintf("%s/gateway/api/graphql?accountId=%s", endpoint, accountID)
 
-	return Harness.NewClient(apiKey, url), nil
+	return Harness.NewClient("", url,
+		Harness.WithAuthenticator(auth),
+		Harness.WithLogger(terraformLogger{}),
+		Harness.WithRateLimit(maxRequestsPerSecond),
+		Harness.WithMaxConcurrentRequests(maxConcurrentRequests),
+		Harness.WithBatching(batchWindow, maxBatchSize),
+	), nil
+}
+
+// authenticator returns the authenticator configured by the auth block,
+// falling back to api_key.
+func authenticator(d *schema.ResourceData) (Harness.Authenticator, error) {
+	if v, ok := d.GetOk("auth.0.api_key"); ok {
+		return Harness.APIKey(v.(string)), nil
+	}
+	if v, ok := d.GetOk("auth.0.bearer_token"); ok {
+		return Harness.BearerToken(v.(string)), nil
+	}
+	if v, ok := d.GetOk("auth.0.token_file"); ok {
+		return Harness.TokenFile(v.(string)), nil
+	}
+
+	if v, ok := d.GetOk("api_key"); ok {
+		return Harness.APIKey(v.(string)), nil
+	}
+
+	return nil, fmt.Errorf("either api_key or an auth block must be configured to authenticate with Harness.io")
 }
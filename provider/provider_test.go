@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// providerConfig is prepended to the configuration of every test step. The
+// client itself is taken from the test server rather than configured.
+const providerConfig = `
+provider "harness" {
+  account_id = "harnesstest"
+}
+`
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// providerFactories returns a provider whose client talks to server.
+func providerFactories(server *harnesstest.Server) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"harness": func() (*schema.Provider, error) {
+			p := Provider()
+			p.ConfigureContextFunc = func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+				return server.Client(), nil
+			}
+			return p, nil
+		},
+	}
+}
+
+// unitTest runs steps against server with resource.UnitTest, then makes sure
+// checkDestroy passes once everything was destroyed.
+//
+// Like any resource.UnitTest this needs the terraform CLI. Rather than
+// downloading it, the test is skipped unless it is on the PATH or
+// TF_ACC_TERRAFORM_PATH or TF_ACC_TERRAFORM_VERSION tell where to find it.
+func unitTest(t *testing.T, server *harnesstest.Server, checkDestroy resource.TestCheckFunc, steps ...resource.TestStep) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") == "" && os.Getenv("TF_ACC_TERRAFORM_VERSION") == "" {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("the terraform CLI is needed to run provider tests")
+		}
+	}
+
+	for i := range steps {
+		if steps[i].Config != "" {
+			steps[i].Config = providerConfig + steps[i].Config
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: providerFactories(server),
+		CheckDestroy:      checkDestroy,
+		Steps:             steps,
+	})
+}
+
+// testCheckDestroyed checks that the server no longer stores any of the
+// resources of resourceType, which it stores as entities of kind.
+func testCheckDestroyed(server *harnesstest.Server, resourceType string, kind string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if _, ok := server.Entity(kind, rs.Primary.ID); ok {
+				return fmt.Errorf("%s %s still exists", resourceType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// testCheckStored checks that the entity the server stores for the resource
+// called name has value for key.
+func testCheckStored(server *harnesstest.Server, name string, kind string, key string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+
+		entity, ok := server.Entity(kind, rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("%s %s does not exist", name, rs.Primary.ID)
+		}
+		if got := fmt.Sprint(entity[key]); got != fmt.Sprint(value) {
+			return fmt.Errorf("%s %s has %s %q, want %q", name, rs.Primary.ID, key, got, fmt.Sprint(value))
+		}
+		return nil
+	}
+}
+
+// importAppEntityID returns the import id of the application entity called
+// name, of the form app_id/id.
+func importAppEntityID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("%s not found in state", name)
+		}
+		return rs.Primary.Attributes["app_id"] + "/" + rs.Primary.ID, nil
+	}
+}
@@ -2,12 +2,19 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	Harness "github.com/eu-evops/terraform-provider-harness/harness"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// applicationFields maps application input fields to their attributes.
+var applicationFields = map[string]string{
+	"name":        "name",
+	"description": "description",
+}
+
 func resourceApplication() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -35,9 +42,9 @@ func resourceApplicationCreate(c context.Context, d *schema.ResourceData, meta i
 		Description: d.Get("description").(string),
 	}
 
-	app, err := client.NewApplication(app)
+	app, err := client.NewApplication(c, app)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to create application", applicationFields)
 	}
 
 	d.SetId(app.ID)
@@ -46,26 +53,17 @@ func resourceApplicationCreate(c context.Context, d *schema.ResourceData, meta i
 
 func resourceApplicationRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
-	app, err := client.GetApplication(d.Id())
+	app, err := getApplication(c, client, d)
 
-	_, userNotAuthorised := err.(*Harness.UserNotAuthorisedError)
-	if userNotAuthorised {
-		app, err = client.GetApplicationByName(d.Get("name").(string))
-	}
-
-	_, applicationNotFound := err.(*Harness.ApplicationNotFound)
-	if applicationNotFound {
+	if errors.Is(err, Harness.ErrNotFound) {
 		d.SetId("")
 		return nil
 	}
 
-	if err != nil && !userNotAuthorised && !applicationNotFound {
-		return diag.FromErr(err)
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read application", applicationFields)
 	}
 
-	// When application is not found, it gives authorisation error instead of app not found error
-	// We'll try querying by name to make sure app does not exist
-
 	d.Set("name", app.Name)
 	d.Set("description", app.Description)
 
@@ -81,9 +79,9 @@ func resourceApplicationUpdate(c context.Context, d *schema.ResourceData, meta i
 		Description: d.Get("description").(string),
 	}
 
-	app, err := client.UpdateApplication(app)
+	app, err := client.UpdateApplication(c, app)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to update application", applicationFields)
 	}
 
 	return nil
@@ -92,12 +90,35 @@ func resourceApplicationUpdate(c context.Context, d *schema.ResourceData, meta i
 func resourceApplicationDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 
-	err := client.DeleteApplication(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	err := client.DeleteApplication(c, d.Id())
+
+	// Deleting an application that is already gone fails the same way as
+	// reading it, so check it still exists before reporting the error
+	if errors.Is(err, Harness.ErrUnauthorized) {
+		if _, getErr := getApplication(c, client, d); errors.Is(getErr, Harness.ErrNotFound) {
+			err = getErr
+		}
+	}
+
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete application", applicationFields)
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+// getApplication gets the application of d, returning ErrNotFound when it
+// does not exist.
+func getApplication(c context.Context, client *Harness.Client, d *schema.ResourceData) (*Harness.Application, error) {
+	app, err := client.GetApplication(c, d.Id())
+
+	// When application is not found, it gives authorisation error instead of app not found error
+	// We'll try querying by name to make sure app does not exist
+	if errors.Is(err, Harness.ErrUnauthorized) {
+		app, err = client.GetApplicationByName(c, d.Get("name").(string))
+	}
+
+	return app, err
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceApplication(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_application", "application"),
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name        = "app"
+  description = "first"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrSet("harness_application.app", "id"),
+				testCheckStored(server, "harness_application.app", "application", "description", "first"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "renamed"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_application.app", "name", "renamed"),
+				resource.TestCheckResourceAttr("harness_application.app", "description", ""),
+				testCheckStored(server, "harness_application.app", "application", "name", "renamed"),
+			),
+		},
+	)
+}
+
+func TestResourceApplicationDeleteWhenGone(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceApplication().Schema, map[string]interface{}{
+		"name": "deleted-app",
+	})
+	d.SetId("deleted-app-id")
+
+	if diags := resourceApplicationDelete(context.Background(), d, server.Client()); diags.HasError() {
+		t.Fatalf("deleting an application that no longer exists failed: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %q, want it cleared", d.Id())
+	}
+}
+
+func TestResourceApplicationDeleteWhenRenamed(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+	client := server.Client()
+
+	d := schema.TestResourceDataRaw(t, resourceApplication().Schema, map[string]interface{}{
+		"name": "app",
+	})
+	if diags := resourceApplicationCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the application failed: %v", diags)
+	}
+
+	stale := schema.TestResourceDataRaw(t, resourceApplication().Schema, map[string]interface{}{
+		"name": "app",
+	})
+	stale.SetId("unknown-id")
+
+	if diags := resourceApplicationDelete(context.Background(), stale, client); !diags.HasError() {
+		t.Error("deleting an unknown id succeeded while an application of that name exists")
+	}
+	if _, ok := server.Entity("application", d.Id()); !ok {
+		t.Error("the existing application was deleted")
+	}
+}
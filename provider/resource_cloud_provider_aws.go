@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudProviderAwsFields maps AWS cloud provider input fields to their attributes.
+var cloudProviderAwsFields = map[string]string{
+	"name":                   "name",
+	"credentialsType":        "access_key",
+	"manualCredentials":      "access_key",
+	"accessKey":              "access_key",
+	"accessKeySecretId":      "access_key",
+	"secretKeySecretId":      "access_key",
+	"ec2IamCredentials":      "ec2_iam_role",
+	"irsaCredentials":        "irsa",
+	"crossAccountAttributes": "assume_cross_account_role",
+	"crossAccountRoleArn":    "assume_cross_account_role",
+	"usageScope":             "scope",
+}
+
+var cloudProviderAwsCredentials = []string{"access_key", "ec2_iam_role", "irsa"}
+
+func resourceCloudProviderAws() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"access_key": {
+				Type:         schema.TypeList,
+				Description:  "Authenticate with the access key of an IAM user",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: cloudProviderAwsCredentials,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"access_key.0.access_key_id", "access_key.0.access_key_id_secret_id"},
+						},
+						"access_key_id_secret_id": {
+							Type:         schema.TypeString,
+							Description:  "The id of the encrypted text holding the access key id, instead of access_key_id",
+							Optional:     true,
+							ExactlyOneOf: []string{"access_key.0.access_key_id", "access_key.0.access_key_id_secret_id"},
+						},
+						"secret_key_secret_id": {
+							Type:        schema.TypeString,
+							Description: "The id of the encrypted text holding the secret access key",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"ec2_iam_role": {
+				Type:         schema.TypeList,
+				Description:  "Authenticate as the IAM role of the EC2 instances of the delegates",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: cloudProviderAwsCredentials,
+				Elem:         cloudProviderAwsDelegateCredentials(),
+			},
+			"irsa": {
+				Type:         schema.TypeList,
+				Description:  "Authenticate as the IAM role of the service account of the delegates running in EKS",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: cloudProviderAwsCredentials,
+				Elem:         cloudProviderAwsDelegateCredentials(),
+			},
+			"assume_cross_account_role": {
+				Type:        schema.TypeList,
+				Description: "Assume a role in another account with the credentials",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"external_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceCloudProviderAwsCreate,
+		ReadContext:   resourceCloudProviderAwsRead,
+		UpdateContext: resourceCloudProviderAwsUpdate,
+		DeleteContext: resourceCloudProviderAwsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func cloudProviderAwsDelegateCredentials() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"delegate_selectors": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func expandCloudProviderAwsCredentials(d *schema.ResourceData) *Harness.CloudProviderAwsCredentials {
+	credentials := &Harness.CloudProviderAwsCredentials{}
+
+	if accessKey := d.Get("access_key").([]interface{}); len(accessKey) > 0 && accessKey[0] != nil {
+		key := accessKey[0].(map[string]interface{})
+		credentials.Type = Harness.AwsCredentialsTypeManual
+		credentials.AccessKey = key["access_key_id"].(string)
+		credentials.AccessKeySecretID = key["access_key_id_secret_id"].(string)
+		credentials.SecretKeySecretID = key["secret_key_secret_id"].(string)
+	}
+
+	for attribute, credentialsType := range map[string]Harness.AwsCredentialsType{
+		"ec2_iam_role": Harness.AwsCredentialsTypeEc2IAM,
+		"irsa":         Harness.AwsCredentialsTypeIrsa,
+	} {
+		if delegate := d.Get(attribute).([]interface{}); len(delegate) > 0 && delegate[0] != nil {
+			credentials.Type = credentialsType
+			for _, selector := range delegate[0].(map[string]interface{})["delegate_selectors"].(*schema.Set).List() {
+				credentials.DelegateSelectors = append(credentials.DelegateSelectors, selector.(string))
+			}
+		}
+	}
+
+	if crossAccount := d.Get("assume_cross_account_role").([]interface{}); len(crossAccount) > 0 && crossAccount[0] != nil {
+		role := crossAccount[0].(map[string]interface{})
+		credentials.CrossAccountRoleARN = role["role_arn"].(string)
+		credentials.ExternalID = role["external_id"].(string)
+	}
+
+	return credentials
+}
+
+func resourceCloudProviderAwsCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	cp, err := client.NewCloudProviderAws(
+		c,
+		d.Get("name").(string),
+		expandCloudProviderAwsCredentials(d),
+		expandUsageScope(d),
+	)
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create AWS cloud provider", cloudProviderAwsFields)
+	}
+
+	d.SetId(cp.ID)
+	return resourceCloudProviderAwsRead(c, d, meta)
+}
+
+func resourceCloudProviderAwsRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	cp, err := client.GetCloudProviderAws(c, d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read AWS cloud provider", cloudProviderAwsFields)
+	}
+
+	d.Set("name", cp.Name)
+
+	d.Set("access_key", nil)
+	d.Set("ec2_iam_role", nil)
+	d.Set("irsa", nil)
+	switch cp.CredentialsType {
+	case Harness.AwsCredentialsTypeManual:
+		d.Set("access_key", []interface{}{map[string]interface{}{
+			"access_key_id":           cp.AccessKey,
+			"access_key_id_secret_id": cp.AccessKeySecretID,
+			"secret_key_secret_id":    cp.SecretKeySecretID,
+		}})
+	case Harness.AwsCredentialsTypeEc2IAM:
+		d.Set("ec2_iam_role", []interface{}{map[string]interface{}{
+			"delegate_selectors": cp.DelegateSelectors,
+		}})
+	case Harness.AwsCredentialsTypeIrsa:
+		d.Set("irsa", []interface{}{map[string]interface{}{
+			"delegate_selectors": cp.DelegateSelectors,
+		}})
+	}
+
+	if cp.AssumeCrossAccountRole {
+		d.Set("assume_cross_account_role", []interface{}{map[string]interface{}{
+			"role_arn":    cp.CrossAccountRoleARN,
+			"external_id": cp.ExternalID,
+		}})
+	} else {
+		d.Set("assume_cross_account_role", nil)
+	}
+
+	d.Set("scope", flattenUsageScope(cp.UsageScope))
+
+	return nil
+}
+
+func resourceCloudProviderAwsUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	_, err := client.UpdateCloudProviderAws(
+		c,
+		d.Id(),
+		d.Get("name").(string),
+		expandCloudProviderAwsCredentials(d),
+		expandUsageScope(d),
+	)
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update AWS cloud provider", cloudProviderAwsFields)
+	}
+
+	return resourceCloudProviderAwsRead(c, d, meta)
+}
+
+func resourceCloudProviderAwsDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteCloudProviderAws(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete AWS cloud provider", cloudProviderAwsFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceCloudProviderAws(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_cloud_provider_aws", "cloudProvider"),
+		resource.TestStep{
+			Config: awsSecretKey + `
+resource "harness_cloud_provider_aws" "aws" {
+  name = "aws"
+
+  access_key {
+    access_key_id        = "AKIAEXAMPLE"
+    secret_key_secret_id = harness_encrypted_secret.secret_key.id
+  }
+
+  assume_cross_account_role {
+    role_arn    = "arn:aws:iam::123456789012:role/deploy"
+    external_id = "harness"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				testCheckStored(server, "harness_cloud_provider_aws.aws", "cloudProvider", "accessKey", "AKIAEXAMPLE"),
+				testCheckStored(server, "harness_cloud_provider_aws.aws", "cloudProvider", "assumeCrossAccountRole", true),
+			),
+		},
+		resource.TestStep{
+			Config: awsSecretKey + `
+resource "harness_cloud_provider_aws" "aws" {
+  name = "aws"
+
+  irsa {
+    delegate_selectors = ["eks"]
+  }
+
+  scope {
+    application_type = "ALL"
+    environment_type = "NON_PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_cloud_provider_aws.aws", "access_key.#", "0"),
+				resource.TestCheckResourceAttr("harness_cloud_provider_aws.aws", "irsa.0.delegate_selectors.#", "1"),
+				testCheckStored(server, "harness_cloud_provider_aws.aws", "cloudProvider", "accessKey", ""),
+				testCheckStored(server, "harness_cloud_provider_aws.aws", "cloudProvider", "assumeCrossAccountRole", false),
+				resource.TestCheckResourceAttr("harness_cloud_provider_aws.aws", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_cloud_provider_aws.aws", "scope.0.environment_type", "NON_PRODUCTION_ENVIRONMENTS"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_cloud_provider_aws.aws",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -2,12 +2,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	Harness "github.com/eu-evops/terraform-provider-harness/harness"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// cloudProviderAzureFields maps Azure cloud provider input fields to their attributes.
+var cloudProviderAzureFields = map[string]string{
+	"name":        "name",
+	"clientId":    "client_id",
+	"tenantId":    "tenant_id",
+	"keySecretId": "encrypted_secret_id",
+}
+
 func resourceCloudProviderAzure() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -42,13 +51,14 @@ func resourceCloudProviderAzure() *schema.Resource {
 func resourceCloudProviderAzureCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 	app, err := client.NewCloudProviderAzure(
+		c,
 		d.Get("name").(string),
 		d.Get("encrypted_secret_id").(string),
 		d.Get("client_id").(string),
 		d.Get("tenant_id").(string),
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to create Azure cloud provider", cloudProviderAzureFields)
 	}
 
 	d.SetId(app.ID)
@@ -57,10 +67,15 @@ func resourceCloudProviderAzureCreate(c context.Context, d *schema.ResourceData,
 
 func resourceCloudProviderAzureRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
-	app, err := client.GetCloudProviderAzure(d.Id())
+	app, err := client.GetCloudProviderAzure(c, d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
 
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to read Azure cloud provider", cloudProviderAzureFields)
 	}
 
 	d.Set("name", app.Name)
@@ -71,13 +86,14 @@ func resourceCloudProviderAzureRead(c context.Context, d *schema.ResourceData, m
 func resourceCloudProviderAzureUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 	app, err := client.UpdateCloudProviderAzure(
+		c,
 		d.Id(),
 		d.Get("name").(string),
 		d.Get("client_id").(string),
 		d.Get("tenant_id").(string),
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to update Azure cloud provider", cloudProviderAzureFields)
 	}
 
 	d.Set("name", app.Name)
@@ -88,9 +104,9 @@ func resourceCloudProviderAzureUpdate(c context.Context, d *schema.ResourceData,
 func resourceCloudProviderAzureDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 
-	err := client.DeleteCloudProviderAzure(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	err := client.DeleteCloudProviderAzure(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete Azure cloud provider", cloudProviderAzureFields)
 	}
 
 	d.SetId("")
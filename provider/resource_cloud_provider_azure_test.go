@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceCloudProviderAzure(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_cloud_provider_azure", "cloudProvider"),
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "key" {
+  name              = "azure-key"
+  value             = "key"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_cloud_provider_azure" "azure" {
+  name                = "azure"
+  encrypted_secret_id = harness_encrypted_secret.key.id
+  client_id           = "client"
+  tenant_id           = "tenant"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_cloud_provider_azure.azure", "name", "azure"),
+				testCheckStored(server, "harness_cloud_provider_azure.azure", "cloudProvider", "tenantId", "tenant"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "key" {
+  name              = "azure-key"
+  value             = "key"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_cloud_provider_azure" "azure" {
+  name                = "azure-renamed"
+  encrypted_secret_id = harness_encrypted_secret.key.id
+  client_id           = "other-client"
+  tenant_id           = "tenant"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				testCheckStored(server, "harness_cloud_provider_azure.azure", "cloudProvider", "name", "azure-renamed"),
+				testCheckStored(server, "harness_cloud_provider_azure.azure", "cloudProvider", "clientId", "other-client"),
+			),
+		},
+	)
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cloudProviderGcpFields maps GCP cloud provider input fields to their attributes.
+var cloudProviderGcpFields = map[string]string{
+	"name":                      "name",
+	"serviceAccountKeySecretId": "service_account_key_secret_id",
+	"delegateSelectors":         "delegate_selectors",
+	"skipValidation":            "skip_validation",
+	"usageScope":                "scope",
+}
+
+func resourceCloudProviderGcp() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_account_key_secret_id": {
+				Type:         schema.TypeString,
+				Description:  "The id of the encrypted file holding the JSON key of the service account",
+				Optional:     true,
+				ExactlyOneOf: []string{"service_account_key_secret_id", "delegate_selectors"},
+			},
+			"delegate_selectors": {
+				Type:         schema.TypeSet,
+				Description:  "The selectors of the delegates whose service account is used, instead of a key",
+				Optional:     true,
+				ExactlyOneOf: []string{"service_account_key_secret_id", "delegate_selectors"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"skip_validation": {
+				Type:        schema.TypeBool,
+				Description: "Whether to skip checking the credentials when saving the cloud provider",
+				Optional:    true,
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceCloudProviderGcpCreate,
+		ReadContext:   resourceCloudProviderGcpRead,
+		UpdateContext: resourceCloudProviderGcpUpdate,
+		DeleteContext: resourceCloudProviderGcpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceCloudProviderGcpCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	cp, err := client.NewCloudProviderGcp(
+		c,
+		d.Get("name").(string),
+		d.Get("service_account_key_secret_id").(string),
+		expandDelegateSelectors(d),
+		d.Get("skip_validation").(bool),
+		expandUsageScope(d),
+	)
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create GCP cloud provider", cloudProviderGcpFields)
+	}
+
+	d.SetId(cp.ID)
+	return resourceCloudProviderGcpRead(c, d, meta)
+}
+
+func resourceCloudProviderGcpRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	cp, err := client.GetCloudProviderGcp(c, d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read GCP cloud provider", cloudProviderGcpFields)
+	}
+
+	d.Set("name", cp.Name)
+	d.Set("service_account_key_secret_id", cp.ServiceAccountKeySecretID)
+	d.Set("delegate_selectors", cp.DelegateSelectors)
+	d.Set("skip_validation", cp.SkipValidation)
+	d.Set("scope", flattenUsageScope(cp.UsageScope))
+
+	return nil
+}
+
+func resourceCloudProviderGcpUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	_, err := client.UpdateCloudProviderGcp(
+		c,
+		d.Id(),
+		d.Get("name").(string),
+		d.Get("service_account_key_secret_id").(string),
+		expandDelegateSelectors(d),
+		d.Get("skip_validation").(bool),
+		expandUsageScope(d),
+	)
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update GCP cloud provider", cloudProviderGcpFields)
+	}
+
+	return resourceCloudProviderGcpRead(c, d, meta)
+}
+
+func resourceCloudProviderGcpDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteCloudProviderGcp(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete GCP cloud provider", cloudProviderGcpFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
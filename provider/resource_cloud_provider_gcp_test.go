@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceCloudProviderGcp(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_cloud_provider_gcp", "cloudProvider"),
+		resource.TestStep{
+			Config: gcpServiceAccountKey + `
+resource "harness_cloud_provider_gcp" "gcp" {
+  name                          = "gcp"
+  service_account_key_secret_id = harness_encrypted_file.service_account_key.id
+}
+`,
+			Check: resource.TestCheckResourceAttrPair("harness_cloud_provider_gcp.gcp", "service_account_key_secret_id", "harness_encrypted_file.service_account_key", "id"),
+		},
+		resource.TestStep{
+			Config: gcpServiceAccountKey + `
+resource "harness_cloud_provider_gcp" "gcp" {
+  name                          = "gcp-renamed"
+  service_account_key_secret_id = harness_encrypted_file.service_account_key.id
+  skip_validation               = true
+}
+`,
+			Check: testCheckStored(server, "harness_cloud_provider_gcp.gcp", "cloudProvider", "name", "gcp-renamed"),
+		},
+		resource.TestStep{
+			Config: gcpServiceAccountKey + `
+resource "harness_cloud_provider_gcp" "gcp" {
+  name               = "gcp-renamed"
+  delegate_selectors = ["gcp"]
+
+  scope {
+    application_type = "ALL"
+    environment_type = "PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_cloud_provider_gcp.gcp", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_cloud_provider_gcp.gcp", "scope.0.environment_type", "PRODUCTION_ENVIRONMENTS"),
+				resource.TestCheckResourceAttr("harness_cloud_provider_gcp.gcp", "service_account_key_secret_id", ""),
+				resource.TestCheckResourceAttr("harness_cloud_provider_gcp.gcp", "delegate_selectors.#", "1"),
+				testCheckStored(server, "harness_cloud_provider_gcp.gcp", "cloudProvider", "serviceAccountKeySecretId", ""),
+				testCheckStored(server, "harness_cloud_provider_gcp.gcp", "cloudProvider", "useDelegateSelectors", true),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_cloud_provider_gcp.gcp",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -2,12 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 
 	Harness "github.com/eu-evops/terraform-provider-harness/harness"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// cloudProviderKubernetesFields maps Kubernetes cloud provider input fields to their attributes.
+var cloudProviderKubernetesFields = map[string]string{
+	"name":                        "name",
+	"masterUrl":                   "url",
+	"serviceAccountTokenSecretId": "token_secret_id",
+}
+
 func resourceCloudProviderKubernetes() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -38,12 +46,13 @@ func resourceCloudProviderKubernetes() *schema.Resource {
 func resourceCloudProviderKubernetesCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 	app, err := client.NewCloudProviderKubernetes(
+		c,
 		d.Get("name").(string),
 		d.Get("token_secret_id").(string),
 		d.Get("url").(string),
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to create Kubernetes cloud provider", cloudProviderKubernetesFields)
 	}
 
 	d.SetId(app.ID)
@@ -52,10 +61,15 @@ func resourceCloudProviderKubernetesCreate(c context.Context, d *schema.Resource
 
 func resourceCloudProviderKubernetesRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
-	app, err := client.GetCloudProviderKubernetes(d.Id())
+	app, err := client.GetCloudProviderKubernetes(c, d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
 
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to read Kubernetes cloud provider", cloudProviderKubernetesFields)
 	}
 
 	d.Set("name", app.Name)
@@ -66,13 +80,14 @@ func resourceCloudProviderKubernetesRead(c context.Context, d *schema.ResourceDa
 func resourceCloudProviderKubernetesUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 	app, err := client.UpdateCloudProviderKubernetes(
+		c,
 		d.Id(),
 		d.Get("name").(string),
 		d.Get("url").(string),
 		d.Get("token_secret_id").(string),
 	)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to update Kubernetes cloud provider", cloudProviderKubernetesFields)
 	}
 
 	d.Set("name", app.Name)
@@ -83,9 +98,9 @@ func resourceCloudProviderKubernetesUpdate(c context.Context, d *schema.Resource
 func resourceCloudProviderKubernetesDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 
-	err := client.DeleteCloudProviderKubernetes(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	err := client.DeleteCloudProviderKubernetes(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete Kubernetes cloud provider", cloudProviderKubernetesFields)
 	}
 
 	d.SetId("")
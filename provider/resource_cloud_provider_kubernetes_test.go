@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceCloudProviderKubernetes(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_cloud_provider_kubernetes", "cloudProvider"),
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "token" {
+  name              = "k8s-token"
+  value             = "token"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_cloud_provider_kubernetes" "k8s" {
+  name            = "k8s"
+  url             = "https://k8s.example.com"
+  token_secret_id = harness_encrypted_secret.token.id
+}
+`,
+			Check: testCheckStored(server, "harness_cloud_provider_kubernetes.k8s", "cloudProvider", "cloudProviderType", "KUBERNETES_CLUSTER"),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "token" {
+  name              = "k8s-token"
+  value             = "token"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_cloud_provider_kubernetes" "k8s" {
+  name            = "k8s-renamed"
+  url             = "https://k8s.example.com"
+  token_secret_id = harness_encrypted_secret.token.id
+}
+`,
+			Check: testCheckStored(server, "harness_cloud_provider_kubernetes.k8s", "cloudProvider", "name", "k8s-renamed"),
+		},
+	)
+}
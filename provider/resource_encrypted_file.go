@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// encryptedFileFields maps encrypted file input fields to their attributes.
+var encryptedFileFields = map[string]string{
+	"name":            "name",
+	"content":         "content_base64",
+	"secretManagerId": "secret_manager_id",
+	"scopedToAccount": "scoped_to_account",
+	"usageScope":      "scope",
+}
+
+func resourceEncryptedFile() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"content_base64": {
+				Type:         schema.TypeString,
+				Description:  "The content of the file, base64 encoded. Only its hash is kept in the state",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"content_base64", "file_path"},
+				ValidateFunc: validateBase64,
+				StateFunc:    hashBase64,
+			},
+			"file_path": {
+				Type:        schema.TypeString,
+				Description: "The path of a local file to upload",
+				Optional:    true,
+			},
+			"content_hash": {
+				Type:        schema.TypeString,
+				Description: "The SHA-256 hash of the content uploaded, used to detect changes to it",
+				Computed:    true,
+			},
+			"secret_manager_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scoped_to_account": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceEncryptedFileCreate,
+		ReadContext:   resourceEncryptedFileRead,
+		UpdateContext: resourceEncryptedFileUpdate,
+		DeleteContext: resourceEncryptedFileDelete,
+		CustomizeDiff: customizeEncryptedFileDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func validateBase64(v interface{}, k string) ([]string, []error) {
+	if _, err := base64.StdEncoding.DecodeString(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s is not valid base64: %v", k, err)}
+	}
+	return nil, nil
+}
+
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// hashBase64 stores the hash of the content in place of the content itself,
+// so that the state never holds the plaintext.
+func hashBase64(v interface{}) string {
+	content, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		return ""
+	}
+	return contentHash(content)
+}
+
+// encryptedFileContent returns the content of the file to upload, either
+// decoded from content_base64 or read from file_path.
+func encryptedFileContent(d *schema.ResourceData) ([]byte, error) {
+	if path := d.Get("file_path").(string); path != "" {
+		return ioutil.ReadFile(path)
+	}
+	return base64.StdEncoding.DecodeString(d.Get("content_base64").(string))
+}
+
+// customizeEncryptedFileDiff plans an update of content_hash when the local
+// file or the inline content changed, Harness.io never returning the content
+// to compare it with.
+func customizeEncryptedFileDiff(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("file_path") || !d.NewValueKnown("content_base64") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	var hash string
+	if path := d.Get("file_path").(string); path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading file_path: %w", err)
+		}
+		hash = contentHash(content)
+	} else if d.HasChange("content_base64") {
+		// The planned value is the one configured, not yet hashed.
+		hash = hashBase64(d.Get("content_base64"))
+	} else {
+		return nil
+	}
+
+	if hash != d.Get("content_hash").(string) {
+		return d.SetNew("content_hash", hash)
+	}
+	return nil
+}
+
+func resourceEncryptedFileCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	content, err := encryptedFileContent(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	file, err := client.NewEncryptedFile(c, &Harness.EncryptedFileSecret{
+		Name:            d.Get("name").(string),
+		Content:         content,
+		SecretManagerID: d.Get("secret_manager_id").(string),
+		ScopedToAccount: d.Get("scoped_to_account").(bool),
+		UsageScope:      expandUsageScope(d),
+	})
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create encrypted file", encryptedFileFields)
+	}
+
+	d.SetId(file.ID)
+	d.Set("content_hash", contentHash(content))
+
+	return resourceEncryptedFileRead(c, d, meta)
+}
+
+func resourceEncryptedFileRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	file, err := client.GetEncryptedFile(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read encrypted file", encryptedFileFields)
+	}
+
+	d.Set("name", file.Name)
+	d.Set("secret_manager_id", file.SecretManagerID)
+	d.Set("scoped_to_account", file.ScopedToAccount)
+	d.Set("scope", flattenUsageScope(file.UsageScope))
+
+	return nil
+}
+
+func resourceEncryptedFileUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	file := &Harness.EncryptedFileSecret{
+		ID:              d.Id(),
+		Name:            d.Get("name").(string),
+		ScopedToAccount: d.Get("scoped_to_account").(bool),
+		UsageScope:      expandUsageScope(d),
+	}
+
+	if d.HasChanges("content_hash", "content_base64", "file_path") {
+		content, err := encryptedFileContent(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		file.Content = content
+	}
+
+	if _, err := client.UpdateEncryptedFile(c, file); err != nil {
+		return harnessDiagnostics(err, "Unable to update encrypted file", encryptedFileFields)
+	}
+
+	if file.Content != nil {
+		d.Set("content_hash", contentHash(file.Content))
+	}
+
+	return resourceEncryptedFileRead(c, d, meta)
+}
+
+func resourceEncryptedFileDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteEncryptedFile(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete encrypted file", encryptedFileFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
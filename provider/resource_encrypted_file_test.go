@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceEncryptedFile(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_encrypted_file", "secret"),
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_file" "key" {
+  name              = "key.pem"
+  content_base64    = "Zmlyc3Q="
+  secret_manager_id = "builtin"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrSet("harness_encrypted_file.key", "content_hash"),
+				testCheckSecretValue(server, "harness_encrypted_file.key", "first"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_file" "key" {
+  name              = "key.pem"
+  content_base64    = "c2Vjb25k"
+  secret_manager_id = "builtin"
+
+  scope {
+    application_type = "ALL"
+    environment_type = "NON_PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				testCheckSecretValue(server, "harness_encrypted_file.key", "second"),
+				resource.TestCheckResourceAttr("harness_encrypted_file.key", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_encrypted_file.key", "scope.0.environment_type", "NON_PRODUCTION_ENVIRONMENTS"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:            "harness_encrypted_file.key",
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"content_base64", "content_hash"},
+		},
+	)
+}
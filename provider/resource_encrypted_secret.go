@@ -2,14 +2,23 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	Harness "github.com/eu-evops/terraform-provider-harness/harness"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// encryptedSecretFields maps encrypted text input fields to their attributes.
+var encryptedSecretFields = map[string]string{
+	"name":            "name",
+	"value":           "value",
+	"secretManagerId": "secret_manager_id",
+	"scopedToAccount": "scoped_to_account",
+	"usageScope":      "scope",
+}
+
 func resourceEncryptedSecret() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -34,35 +43,7 @@ func resourceEncryptedSecret() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			"scope": {
-				Optional: true,
-				Type:     schema.TypeList,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"application_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"application_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"environment_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"environment_type": {
-							Type:        schema.TypeString,
-							Description: "Either NON_PRODUCTION_ENVIRONMENTS or PRODUCTION_ENVIRONMENTS",
-							Optional:    true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"NON_PRODUCTION_ENVIRONMENTS",
-								"PRODUCTION_ENVIRONMENTS",
-							}, false),
-						},
-					},
-				},
-			},
+			"scope": usageScopeSchema(),
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -83,31 +64,12 @@ func resourceSecretCreate(c context.Context, d *schema.ResourceData, meta interf
 		Value:           d.Get("value").(string),
 		SecretManagerID: d.Get("secret_manager_id").(string),
 		ScopedToAccount: d.Get("scoped_to_account").(bool),
-		UsageScope: &Harness.UsageScope{
-			AppEnvScopes: make([]*Harness.AppEnvScope, 0),
-		},
+		UsageScope:      expandUsageScope(d),
 	}
 
-	if !secret.ScopedToAccount {
-		for _, usageScope := range d.Get("scope").([]interface{}) {
-			uu := usageScope.(map[string]interface{})
-			u := &Harness.AppEnvScope{
-				Application: &Harness.ApplicationScope{
-					AppId:      uu["application_id"].(string),
-					FilterType: uu["application_type"].(string),
-				},
-				Environment: &Harness.EnvironmentScope{
-					EnvId:      uu["environment_id"].(string),
-					FilterType: uu["environment_type"].(string),
-				},
-			}
-			secret.UsageScope.AppEnvScopes = append(secret.UsageScope.AppEnvScopes, u)
-		}
-	}
-
-	app, err := client.NewEncryptedSecret(secret)
+	app, err := client.NewEncryptedSecret(c, secret)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to create encrypted secret", encryptedSecretFields)
 	}
 
 	d.SetId(app.ID)
@@ -118,20 +80,20 @@ func resourceSecretCreate(c context.Context, d *schema.ResourceData, meta interf
 
 func resourceSecretRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
-	app, err := client.GetEncryptedSecret(d.Id())
+	app, err := client.GetEncryptedSecret(c, d.Id())
 
-	_, notFound := err.(*Harness.NotFound)
-	if notFound {
+	if errors.Is(err, Harness.ErrNotFound) {
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to read encrypted secret", encryptedSecretFields)
 	}
 
 	d.Set("name", app.Name)
 	d.Set("scoped_to_account", app.ScopedToAccount)
+	d.Set("scope", flattenUsageScope(app.UsageScope))
 
 	return nil
 }
@@ -144,33 +106,14 @@ func resourceSecretUpdate(c context.Context, d *schema.ResourceData, meta interf
 		Name:            d.Get("name").(string),
 		Value:           d.Get("value").(string),
 		ScopedToAccount: d.Get("scoped_to_account").(bool),
-		UsageScope: &Harness.UsageScope{
-			AppEnvScopes: make([]*Harness.AppEnvScope, 0),
-		},
+		UsageScope:      expandUsageScope(d),
 	}
 
 	log.Printf("[DEBUG] Updating secret with id: %s", secret.ID)
 
-	if !secret.ScopedToAccount {
-		for _, usageScope := range d.Get("scope").([]interface{}) {
-			uu := usageScope.(map[string]interface{})
-			u := &Harness.AppEnvScope{
-				Application: &Harness.ApplicationScope{
-					AppId:      uu["application_id"].(string),
-					FilterType: uu["application_type"].(string),
-				},
-				Environment: &Harness.EnvironmentScope{
-					EnvId:      uu["environment_id"].(string),
-					FilterType: uu["environment_type"].(string),
-				},
-			}
-			secret.UsageScope.AppEnvScopes = append(secret.UsageScope.AppEnvScopes, u)
-		}
-	}
-
-	updatedSecret, err := client.UpdateEncryptedSecret(secret)
+	updatedSecret, err := client.UpdateEncryptedSecret(c, secret)
 	if err != nil {
-		return diag.FromErr(err)
+		return harnessDiagnostics(err, "Unable to update encrypted secret", encryptedSecretFields)
 	}
 
 	d.Set("name", updatedSecret.Name)
@@ -182,9 +125,9 @@ func resourceSecretUpdate(c context.Context, d *schema.ResourceData, meta interf
 func resourceSecretDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Harness.Client)
 
-	err := client.DeleteEncryptedSecret(d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	err := client.DeleteEncryptedSecret(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete encrypted secret", encryptedSecretFields)
 	}
 
 	d.SetId("")
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceEncryptedSecret(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_encrypted_secret", "secret"),
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "password" {
+  name              = "password"
+  value             = "hunter2"
+  secret_manager_id = "builtin"
+}
+`,
+			Check: testCheckSecretValue(server, "harness_encrypted_secret.password", "hunter2"),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "password" {
+  name              = "password"
+  value             = "correct horse"
+  secret_manager_id = "builtin"
+
+  scope {
+    application_type = "ALL"
+    environment_type = "PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				testCheckSecretValue(server, "harness_encrypted_secret.password", "correct horse"),
+				resource.TestCheckResourceAttr("harness_encrypted_secret.password", "scope.#", "1"),
+			),
+		},
+	)
+}
+
+// testCheckSecretValue checks the value the server stores for the secret
+// called name, which Harness.io never returns.
+func testCheckSecretValue(server *harnesstest.Server, name string, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+
+		if got, _ := server.SecretValue(rs.Primary.ID); got != value {
+			return fmt.Errorf("%s has the value %q, want %q", name, got, value)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// environmentFields maps environment input fields to their attributes.
+var environmentFields = map[string]string{
+	"applicationId":     "app_id",
+	"name":              "name",
+	"description":       "description",
+	"environmentType":   "environment_type",
+	"tags":              "tags",
+	"variableOverrides": "variable_override",
+}
+
+func resourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the application the environment belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"environment_type": {
+				Type:        schema.TypeString,
+				Description: "Either PROD or NON_PROD",
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(Harness.EnvironmentTypeProd),
+					string(Harness.EnvironmentTypeNonProd),
+				}, false),
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"variable_override": {
+				Type:        schema.TypeList,
+				Description: "Service variables overridden in the environment",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Type:        schema.TypeString,
+							Description: "The service whose variable is overridden, all services when not set",
+							Optional:    true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Either TEXT, or ENCRYPTED_TEXT when value is the id of a secret",
+							Optional:    true,
+							Default:     string(Harness.VariableOverrideTypeText),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(Harness.VariableOverrideTypeText),
+								string(Harness.VariableOverrideTypeEncryptedText),
+							}, false),
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The value of the variable, or the id of the secret holding it",
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+		CreateContext: resourceEnvironmentCreate,
+		ReadContext:   resourceEnvironmentRead,
+		UpdateContext: resourceEnvironmentUpdate,
+		DeleteContext: resourceEnvironmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importAppEntity,
+		},
+	}
+}
+
+func expandEnvironment(d *schema.ResourceData) *Harness.Environment {
+	env := &Harness.Environment{
+		ID:            d.Id(),
+		ApplicationID: d.Get("app_id").(string),
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		Type:          Harness.EnvironmentType(d.Get("environment_type").(string)),
+		Tags:          expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	for _, o := range d.Get("variable_override").([]interface{}) {
+		override := o.(map[string]interface{})
+		env.VariableOverrides = append(env.VariableOverrides, &Harness.VariableOverride{
+			ServiceID: override["service_id"].(string),
+			Name:      override["name"].(string),
+			Type:      Harness.VariableOverrideType(override["type"].(string)),
+			Value:     override["value"].(string),
+		})
+	}
+
+	return env
+}
+
+func flattenVariableOverrides(overrides []*Harness.VariableOverride) []interface{} {
+	out := make([]interface{}, 0, len(overrides))
+	for _, o := range overrides {
+		out = append(out, map[string]interface{}{
+			"service_id": o.ServiceID,
+			"name":       o.Name,
+			"type":       string(o.Type),
+			"value":      o.Value,
+		})
+	}
+	return out
+}
+
+func resourceEnvironmentCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	env, err := client.NewEnvironment(c, expandEnvironment(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create environment", environmentFields)
+	}
+
+	d.SetId(env.ID)
+	return resourceEnvironmentRead(c, d, meta)
+}
+
+func resourceEnvironmentRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	env, err := client.GetEnvironment(c, d.Get("app_id").(string), d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read environment", environmentFields)
+	}
+
+	d.Set("app_id", env.ApplicationID)
+	d.Set("name", env.Name)
+	d.Set("description", env.Description)
+	d.Set("environment_type", env.Type)
+	d.Set("tags", flattenTags(env.Tags))
+	d.Set("variable_override", flattenVariableOverrides(env.VariableOverrides))
+
+	return nil
+}
+
+func resourceEnvironmentUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	_, err := client.UpdateEnvironment(c, expandEnvironment(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update environment", environmentFields)
+	}
+
+	return resourceEnvironmentRead(c, d, meta)
+}
+
+func resourceEnvironmentDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteEnvironment(c, d.Get("app_id").(string), d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete environment", environmentFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
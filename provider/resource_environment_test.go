@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceEnvironment(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_environment", "environment"),
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_service" "svc" {
+  app_id          = harness_application.app.id
+  name            = "svc"
+  deployment_type = "KUBERNETES"
+}
+
+resource "harness_environment" "dev" {
+  app_id           = harness_application.app.id
+  name             = "dev"
+  environment_type = "NON_PROD"
+
+  variable_override {
+    name  = "replicas"
+    value = "1"
+  }
+
+  variable_override {
+    service_id = harness_service.svc.id
+    name       = "image"
+    value      = "nginx"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_environment.dev", "variable_override.#", "2"),
+				resource.TestCheckResourceAttr("harness_environment.dev", "variable_override.0.type", "TEXT"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_service" "svc" {
+  app_id          = harness_application.app.id
+  name            = "svc"
+  deployment_type = "KUBERNETES"
+}
+
+resource "harness_environment" "dev" {
+  app_id           = harness_application.app.id
+  name             = "dev"
+  environment_type = "PROD"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_environment.dev", "variable_override.#", "0"),
+				testCheckStored(server, "harness_environment.dev", "environment", "type", "PROD"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_environment.dev",
+			ImportState:       true,
+			ImportStateIdFunc: importAppEntityID("harness_environment.dev"),
+			ImportStateVerify: true,
+		},
+	)
+}
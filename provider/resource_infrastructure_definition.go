@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// infrastructureDefinitionFields maps infrastructure definition input fields
+// to their attributes.
+var infrastructureDefinitionFields = map[string]string{
+	"applicationId":    "app_id",
+	"environmentId":    "env_id",
+	"name":             "name",
+	"deploymentType":   "deployment_type",
+	"scopedServices":   "scoped_services",
+	"kubernetesDirect": "kubernetes",
+	"azureKubernetes":  "azure_kubernetes",
+}
+
+// infrastructureTypes are the blocks configuring the infrastructure of an
+// infrastructure definition, of which exactly one must be set.
+var infrastructureTypes = []string{"kubernetes", "azure_kubernetes"}
+
+func resourceInfrastructureDefinition() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the application the infrastructure definition belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"env_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the environment the infrastructure definition belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"deployment_type": {
+				Type:        schema.TypeString,
+				Description: "Either KUBERNETES or HELM",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(Harness.DeploymentTypeKubernetes),
+					string(Harness.DeploymentTypeHelm),
+				}, false),
+			},
+			"scoped_services": {
+				Type:        schema.TypeSet,
+				Description: "The ids of the services the infrastructure definition is limited to, all services when not set",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"kubernetes": {
+				Type:         schema.TypeList,
+				Description:  "A cluster reached through a Kubernetes cloud provider",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: infrastructureTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_provider_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"release_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"azure_kubernetes": {
+				Type:         schema.TypeList,
+				Description:  "An AKS cluster reached through an Azure cloud provider",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: infrastructureTypes,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloud_provider_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subscription_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_group": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"release_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		CreateContext: resourceInfrastructureDefinitionCreate,
+		ReadContext:   resourceInfrastructureDefinitionRead,
+		UpdateContext: resourceInfrastructureDefinitionUpdate,
+		DeleteContext: resourceInfrastructureDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importAppEntity,
+		},
+		CustomizeDiff: customizeInfrastructureDefinitionDiff,
+	}
+}
+
+// customizeInfrastructureDefinitionDiff replaces infrastructure definitions
+// whose infrastructure type changes, which Harness.io cannot update. Changes
+// within the block of a type are updated in place.
+func customizeInfrastructureDefinitionDiff(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, infraType := range infrastructureTypes {
+		old, new := d.GetChange(infraType)
+		if len(old.([]interface{})) != len(new.([]interface{})) {
+			return d.ForceNew(infraType)
+		}
+	}
+
+	return nil
+}
+
+func expandInfrastructureDefinition(d *schema.ResourceData) *Harness.InfrastructureDefinition {
+	infra := &Harness.InfrastructureDefinition{
+		ID:             d.Id(),
+		ApplicationID:  d.Get("app_id").(string),
+		EnvironmentID:  d.Get("env_id").(string),
+		Name:           d.Get("name").(string),
+		DeploymentType: Harness.DeploymentType(d.Get("deployment_type").(string)),
+	}
+
+	for _, id := range d.Get("scoped_services").(*schema.Set).List() {
+		infra.ScopedServices = append(infra.ScopedServices, id.(string))
+	}
+
+	if v, ok := d.GetOk("kubernetes.0"); ok {
+		k := v.(map[string]interface{})
+		infra.InfrastructureType = Harness.InfrastructureTypeKubernetesDirect
+		infra.Details = &Harness.InfrastructureDetails{
+			CloudProviderID: k["cloud_provider_id"].(string),
+			Namespace:       k["namespace"].(string),
+			ReleaseName:     k["release_name"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("azure_kubernetes.0"); ok {
+		k := v.(map[string]interface{})
+		infra.InfrastructureType = Harness.InfrastructureTypeAzureKubernetes
+		infra.Details = &Harness.InfrastructureDetails{
+			CloudProviderID: k["cloud_provider_id"].(string),
+			SubscriptionID:  k["subscription_id"].(string),
+			ResourceGroup:   k["resource_group"].(string),
+			ClusterName:     k["cluster_name"].(string),
+			Namespace:       k["namespace"].(string),
+			ReleaseName:     k["release_name"].(string),
+		}
+	}
+
+	return infra
+}
+
+func flattenInfrastructureDetails(d *schema.ResourceData, infra *Harness.InfrastructureDefinition) {
+	kubernetes := []interface{}{}
+	azureKubernetes := []interface{}{}
+
+	if details := infra.Details; details != nil {
+		switch infra.InfrastructureType {
+		case Harness.InfrastructureTypeKubernetesDirect:
+			kubernetes = append(kubernetes, map[string]interface{}{
+				"cloud_provider_id": details.CloudProviderID,
+				"namespace":         details.Namespace,
+				"release_name":      details.ReleaseName,
+			})
+		case Harness.InfrastructureTypeAzureKubernetes:
+			azureKubernetes = append(azureKubernetes, map[string]interface{}{
+				"cloud_provider_id": details.CloudProviderID,
+				"subscription_id":   details.SubscriptionID,
+				"resource_group":    details.ResourceGroup,
+				"cluster_name":      details.ClusterName,
+				"namespace":         details.Namespace,
+				"release_name":      details.ReleaseName,
+			})
+		}
+	}
+
+	d.Set("kubernetes", kubernetes)
+	d.Set("azure_kubernetes", azureKubernetes)
+}
+
+func resourceInfrastructureDefinitionCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	infra, err := client.NewInfrastructureDefinition(c, expandInfrastructureDefinition(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create infrastructure definition", infrastructureDefinitionFields)
+	}
+
+	d.SetId(infra.ID)
+	return resourceInfrastructureDefinitionRead(c, d, meta)
+}
+
+func resourceInfrastructureDefinitionRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	infra, err := client.GetInfrastructureDefinition(c, d.Get("app_id").(string), d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read infrastructure definition", infrastructureDefinitionFields)
+	}
+
+	d.Set("app_id", infra.ApplicationID)
+	d.Set("env_id", infra.EnvironmentID)
+	d.Set("name", infra.Name)
+	d.Set("deployment_type", infra.DeploymentType)
+	d.Set("scoped_services", infra.ScopedServices)
+	flattenInfrastructureDetails(d, infra)
+
+	return nil
+}
+
+func resourceInfrastructureDefinitionUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	_, err := client.UpdateInfrastructureDefinition(c, expandInfrastructureDefinition(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update infrastructure definition", infrastructureDefinitionFields)
+	}
+
+	return resourceInfrastructureDefinitionRead(c, d, meta)
+}
+
+func resourceInfrastructureDefinitionDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteInfrastructureDefinition(c, d.Get("app_id").(string), d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete infrastructure definition", infrastructureDefinitionFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const infrastructureDefinitionDependencies = `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_environment" "dev" {
+  app_id           = harness_application.app.id
+  name             = "dev"
+  environment_type = "NON_PROD"
+}
+
+resource "harness_encrypted_secret" "token" {
+  name              = "k8s-token"
+  value             = "token"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_cloud_provider_kubernetes" "k8s" {
+  name            = "k8s"
+  url             = "https://k8s.example.com"
+  token_secret_id = harness_encrypted_secret.token.id
+}
+
+resource "harness_cloud_provider_azure" "azure" {
+  name                = "azure"
+  encrypted_secret_id = harness_encrypted_secret.token.id
+  client_id           = "client"
+  tenant_id           = "tenant"
+}
+`
+
+func TestResourceInfrastructureDefinition(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	// id is that of the infrastructure definition before its type changes.
+	var id string
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_infrastructure_definition", "infrastructureDefinition"),
+		resource.TestStep{
+			Config: infrastructureDefinitionDependencies + `
+resource "harness_infrastructure_definition" "k8s" {
+  app_id          = harness_application.app.id
+  env_id          = harness_environment.dev.id
+  name            = "k8s"
+  deployment_type = "KUBERNETES"
+
+  kubernetes {
+    cloud_provider_id = harness_cloud_provider_kubernetes.k8s.id
+    namespace         = "default"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_infrastructure_definition.k8s", "kubernetes.0.namespace", "default"),
+				resource.TestCheckResourceAttrSet("harness_infrastructure_definition.k8s", "kubernetes.0.release_name"),
+			),
+		},
+		resource.TestStep{
+			Config: infrastructureDefinitionDependencies + `
+resource "harness_infrastructure_definition" "k8s" {
+  app_id          = harness_application.app.id
+  env_id          = harness_environment.dev.id
+  name            = "k8s"
+  deployment_type = "KUBERNETES"
+
+  kubernetes {
+    cloud_provider_id = harness_cloud_provider_kubernetes.k8s.id
+    namespace         = "apps"
+    release_name      = "release-$${infra.kubernetes.infraId}"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_infrastructure_definition.k8s", "kubernetes.0.namespace", "apps"),
+				func(s *terraform.State) error {
+					id = s.RootModule().Resources["harness_infrastructure_definition.k8s"].Primary.ID
+					return nil
+				},
+			),
+		},
+		resource.TestStep{
+			Config: infrastructureDefinitionDependencies + `
+resource "harness_infrastructure_definition" "k8s" {
+  app_id          = harness_application.app.id
+  env_id          = harness_environment.dev.id
+  name            = "k8s"
+  deployment_type = "KUBERNETES"
+
+  azure_kubernetes {
+    cloud_provider_id = harness_cloud_provider_azure.azure.id
+    subscription_id   = "subscription"
+    resource_group    = "group"
+    cluster_name      = "cluster"
+    namespace         = "apps"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_infrastructure_definition.k8s", "kubernetes.#", "0"),
+				resource.TestCheckResourceAttr("harness_infrastructure_definition.k8s", "azure_kubernetes.0.cluster_name", "cluster"),
+				func(s *terraform.State) error {
+					if s.RootModule().Resources["harness_infrastructure_definition.k8s"].Primary.ID == id {
+						return fmt.Errorf("infrastructure definition %s was updated rather than replaced", id)
+					}
+					if _, ok := server.Entity("infrastructureDefinition", id); ok {
+						return fmt.Errorf("replaced infrastructure definition %s still exists", id)
+					}
+					return nil
+				},
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_infrastructure_definition.k8s",
+			ImportState:       true,
+			ImportStateIdFunc: importAppEntityID("harness_infrastructure_definition.k8s"),
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// awsKMSSecretManagerFields maps AWS KMS secret manager input fields to their
+// attributes.
+var awsKMSSecretManagerFields = map[string]string{
+	"name":                  "name",
+	"region":                "region",
+	"kmsArn":                "kms_arn",
+	"credentials":           "access_key",
+	"accessKey":             "access_key",
+	"secretKeySecretId":     "access_key",
+	"roleArn":               "assume_sts_role",
+	"externalId":            "assume_sts_role",
+	"assumeStsRoleDuration": "assume_sts_role",
+	"delegateSelectors":     "delegate_selectors",
+	"isDefault":             "default",
+	"usageScope":            "scope",
+}
+
+func resourceSecretManagerAWSKMS() *schema.Resource {
+	return &schema.Resource{
+		Schema: awsSecretManagerSchema(map[string]*schema.Schema{
+			"kms_arn": {
+				Type:        schema.TypeString,
+				Description: "The ARN of the KMS key or alias the secrets are encrypted with",
+				Required:    true,
+				ForceNew:    true,
+			},
+		}),
+		CreateContext: resourceSecretManagerAWSKMSCreate,
+		ReadContext:   resourceSecretManagerAWSKMSRead,
+		UpdateContext: resourceSecretManagerAWSKMSUpdate,
+		DeleteContext: resourceSecretManagerAWSKMSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeAWSSecretManagerDiff,
+	}
+}
+
+func expandAWSKMSSecretManager(d *schema.ResourceData) *Harness.AWSKMSSecretManager {
+	return &Harness.AWSKMSSecretManager{
+		ID:                d.Id(),
+		Name:              d.Get("name").(string),
+		Region:            d.Get("region").(string),
+		KMSARN:            d.Get("kms_arn").(string),
+		Credentials:       expandAWSSecretManagerCredentials(d),
+		DelegateSelectors: expandDelegateSelectors(d),
+		Default:           d.Get("default").(bool),
+		UsageScope:        expandUsageScope(d),
+	}
+}
+
+func resourceSecretManagerAWSKMSCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.NewAWSKMSSecretManager(c, expandAWSKMSSecretManager(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create AWS KMS secret manager", awsKMSSecretManagerFields)
+	}
+
+	d.SetId(sm.ID)
+
+	return resourceSecretManagerAWSKMSRead(c, d, meta)
+}
+
+func resourceSecretManagerAWSKMSRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.GetAWSKMSSecretManager(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read AWS KMS secret manager", awsKMSSecretManagerFields)
+	}
+
+	flattenAWSSecretManager(d, sm.Name, sm.Region, sm.Credentials, sm.DelegateSelectors, sm.Default, sm.UsageScope)
+	d.Set("kms_arn", sm.KMSARN)
+
+	return nil
+}
+
+func resourceSecretManagerAWSKMSUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	if _, err := client.UpdateAWSKMSSecretManager(c, expandAWSKMSSecretManager(d)); err != nil {
+		return harnessDiagnostics(err, "Unable to update AWS KMS secret manager", awsKMSSecretManagerFields)
+	}
+
+	return resourceSecretManagerAWSKMSRead(c, d, meta)
+}
+
+func resourceSecretManagerAWSKMSDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteAWSKMSSecretManager(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete AWS KMS secret manager", awsKMSSecretManagerFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceSecretManagerAWSKMS(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_secret_manager_aws_kms", "secretManager"),
+		resource.TestStep{
+			Config: `
+resource "harness_secret_manager_aws_kms" "kms" {
+  name    = "kms"
+  region  = "us-east-1"
+  kms_arn = "arn:aws:kms:us-east-1:123456789012:key/harness"
+}
+`,
+			ExpectError: regexp.MustCompile("delegate_selectors is required"),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_secret_manager_aws_kms" "kms" {
+  name               = "kms"
+  region             = "us-east-1"
+  kms_arn            = "arn:aws:kms:us-east-1:123456789012:key/harness"
+  delegate_selectors = ["aws"]
+}
+`,
+			Check: resource.TestCheckResourceAttr("harness_secret_manager_aws_kms.kms", "delegate_selectors.#", "1"),
+		},
+		resource.TestStep{
+			Config: awsSecretKey + `
+resource "harness_secret_manager_aws_kms" "kms" {
+  name    = "kms"
+  region  = "us-east-1"
+  kms_arn = "arn:aws:kms:us-east-1:123456789012:key/harness"
+  default = true
+
+  access_key {
+    access_key_id        = "AKIAEXAMPLE"
+    secret_key_secret_id = harness_encrypted_secret.secret_key.id
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_aws_kms.kms", "delegate_selectors.#", "0"),
+				resource.TestCheckResourceAttr("harness_secret_manager_aws_kms.kms", "access_key.0.access_key_id", "AKIAEXAMPLE"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_secret_manager_aws_kms.kms",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// awsSecretsManagerFields maps AWS Secrets Manager secret manager input
+// fields to their attributes.
+var awsSecretsManagerFields = map[string]string{
+	"name":                  "name",
+	"region":                "region",
+	"secretNamePrefix":      "secret_name_prefix",
+	"credentials":           "access_key",
+	"accessKey":             "access_key",
+	"secretKeySecretId":     "access_key",
+	"roleArn":               "assume_sts_role",
+	"externalId":            "assume_sts_role",
+	"assumeStsRoleDuration": "assume_sts_role",
+	"delegateSelectors":     "delegate_selectors",
+	"isDefault":             "default",
+	"usageScope":            "scope",
+}
+
+func resourceSecretManagerAWSSecretsManager() *schema.Resource {
+	return &schema.Resource{
+		Schema: awsSecretManagerSchema(map[string]*schema.Schema{
+			"secret_name_prefix": {
+				Type:        schema.TypeString,
+				Description: "The prefix of the names the secrets are stored under",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		}),
+		CreateContext: resourceSecretManagerAWSSecretsManagerCreate,
+		ReadContext:   resourceSecretManagerAWSSecretsManagerRead,
+		UpdateContext: resourceSecretManagerAWSSecretsManagerUpdate,
+		DeleteContext: resourceSecretManagerAWSSecretsManagerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: customizeAWSSecretManagerDiff,
+	}
+}
+
+func expandAWSSecretsManager(d *schema.ResourceData) *Harness.AWSSecretsManager {
+	return &Harness.AWSSecretsManager{
+		ID:                d.Id(),
+		Name:              d.Get("name").(string),
+		Region:            d.Get("region").(string),
+		SecretNamePrefix:  d.Get("secret_name_prefix").(string),
+		Credentials:       expandAWSSecretManagerCredentials(d),
+		DelegateSelectors: expandDelegateSelectors(d),
+		Default:           d.Get("default").(bool),
+		UsageScope:        expandUsageScope(d),
+	}
+}
+
+func resourceSecretManagerAWSSecretsManagerCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.NewAWSSecretsManager(c, expandAWSSecretsManager(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create AWS Secrets Manager secret manager", awsSecretsManagerFields)
+	}
+
+	d.SetId(sm.ID)
+
+	return resourceSecretManagerAWSSecretsManagerRead(c, d, meta)
+}
+
+func resourceSecretManagerAWSSecretsManagerRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.GetAWSSecretsManager(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read AWS Secrets Manager secret manager", awsSecretsManagerFields)
+	}
+
+	flattenAWSSecretManager(d, sm.Name, sm.Region, sm.Credentials, sm.DelegateSelectors, sm.Default, sm.UsageScope)
+	d.Set("secret_name_prefix", sm.SecretNamePrefix)
+
+	return nil
+}
+
+func resourceSecretManagerAWSSecretsManagerUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	if _, err := client.UpdateAWSSecretsManager(c, expandAWSSecretsManager(d)); err != nil {
+		return harnessDiagnostics(err, "Unable to update AWS Secrets Manager secret manager", awsSecretsManagerFields)
+	}
+
+	return resourceSecretManagerAWSSecretsManagerRead(c, d, meta)
+}
+
+func resourceSecretManagerAWSSecretsManagerDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteAWSSecretsManager(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete AWS Secrets Manager secret manager", awsSecretsManagerFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
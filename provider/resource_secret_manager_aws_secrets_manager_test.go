@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const awsSecretKey = `
+resource "harness_encrypted_secret" "secret_key" {
+  name              = "aws-secret-key"
+  value             = "secret"
+  secret_manager_id = "builtin"
+}
+`
+
+func TestResourceSecretManagerAWSSecretsManager(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_secret_manager_aws_secrets_manager", "secretManager"),
+		resource.TestStep{
+			Config: awsSecretKey + `
+resource "harness_secret_manager_aws_secrets_manager" "aws" {
+  name               = "aws"
+  region             = "eu-west-1"
+  secret_name_prefix = "harness/"
+
+  access_key {
+    access_key_id        = "AKIAEXAMPLE"
+    secret_key_secret_id = harness_encrypted_secret.secret_key.id
+  }
+}
+`,
+			Check: testCheckStored(server, "harness_secret_manager_aws_secrets_manager.aws", "secretManager", "secretNamePrefix", "harness/"),
+		},
+		resource.TestStep{
+			Config: awsSecretKey + `
+resource "harness_secret_manager_aws_secrets_manager" "aws" {
+  name               = "aws"
+  region             = "eu-west-1"
+  secret_name_prefix = "harness/"
+  delegate_selectors = ["aws"]
+
+  assume_sts_role {
+    role_arn = "arn:aws:iam::123456789012:role/harness"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_aws_secrets_manager.aws", "access_key.#", "0"),
+				resource.TestCheckResourceAttr("harness_secret_manager_aws_secrets_manager.aws", "assume_sts_role.0.duration_seconds", "900"),
+			),
+		},
+		resource.TestStep{
+			Config: awsSecretKey + `
+resource "harness_secret_manager_aws_secrets_manager" "aws" {
+  name               = "aws"
+  region             = "eu-west-1"
+  secret_name_prefix = "harness/"
+  delegate_selectors = ["aws"]
+
+  scope {
+    application_type = "ALL"
+    environment_type = "NON_PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_aws_secrets_manager.aws", "assume_sts_role.#", "0"),
+				resource.TestCheckResourceAttr("harness_secret_manager_aws_secrets_manager.aws", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_secret_manager_aws_secrets_manager.aws", "scope.0.application_type", "ALL"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_secret_manager_aws_secrets_manager.aws",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
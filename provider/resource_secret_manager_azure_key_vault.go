@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// azureKeyVaultSecretManagerFields maps Azure Key Vault secret manager input
+// fields to their attributes.
+var azureKeyVaultSecretManagerFields = map[string]string{
+	"name":                 "name",
+	"vaultName":            "vault_name",
+	"subscription":         "subscription",
+	"clientId":             "client_id",
+	"tenantId":             "tenant_id",
+	"clientSecretId":       "client_secret_id",
+	"azureEnvironmentType": "environment_type",
+	"isDefault":            "default",
+	"usageScope":           "scope",
+}
+
+func resourceSecretManagerAzureKeyVault() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vault_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the key vault the secrets are stored in",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subscription": {
+				Type:        schema.TypeString,
+				Description: "The id of the Azure subscription of the key vault",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the application Harness.io authenticates as",
+				Required:    true,
+			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_secret_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the encrypted text holding the secret of the application",
+				Required:    true,
+			},
+			"environment_type": {
+				Type:        schema.TypeString,
+				Description: "Either AZURE or AZURE_US_GOVERNMENT",
+				Optional:    true,
+				Default:     string(Harness.AzureEnvironmentTypeAzure),
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(Harness.AzureEnvironmentTypeAzure),
+					string(Harness.AzureEnvironmentTypeAzureUsGovernment),
+				}, false),
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether new secrets are stored in this secret manager by default",
+				Optional:    true,
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceSecretManagerAzureKeyVaultCreate,
+		ReadContext:   resourceSecretManagerAzureKeyVaultRead,
+		UpdateContext: resourceSecretManagerAzureKeyVaultUpdate,
+		DeleteContext: resourceSecretManagerAzureKeyVaultDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandAzureKeyVaultSecretManager(d *schema.ResourceData) *Harness.AzureKeyVaultSecretManager {
+	return &Harness.AzureKeyVaultSecretManager{
+		ID:              d.Id(),
+		Name:            d.Get("name").(string),
+		VaultName:       d.Get("vault_name").(string),
+		Subscription:    d.Get("subscription").(string),
+		ClientID:        d.Get("client_id").(string),
+		TenantID:        d.Get("tenant_id").(string),
+		ClientSecretID:  d.Get("client_secret_id").(string),
+		EnvironmentType: Harness.AzureEnvironmentType(d.Get("environment_type").(string)),
+		Default:         d.Get("default").(bool),
+		UsageScope:      expandUsageScope(d),
+	}
+}
+
+// flattenAzureKeyVaultSecretManager sets the attributes shared by the
+// resource and the data source.
+func flattenAzureKeyVaultSecretManager(d *schema.ResourceData, sm *Harness.AzureKeyVaultSecretManager) {
+	d.Set("name", sm.Name)
+	d.Set("vault_name", sm.VaultName)
+	d.Set("subscription", sm.Subscription)
+	d.Set("client_id", sm.ClientID)
+	d.Set("tenant_id", sm.TenantID)
+	d.Set("client_secret_id", sm.ClientSecretID)
+	d.Set("environment_type", sm.EnvironmentType)
+	d.Set("default", sm.Default)
+}
+
+func resourceSecretManagerAzureKeyVaultCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.NewAzureKeyVaultSecretManager(c, expandAzureKeyVaultSecretManager(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create Azure Key Vault secret manager", azureKeyVaultSecretManagerFields)
+	}
+
+	d.SetId(sm.ID)
+
+	return resourceSecretManagerAzureKeyVaultRead(c, d, meta)
+}
+
+func resourceSecretManagerAzureKeyVaultRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.GetAzureKeyVaultSecretManager(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read Azure Key Vault secret manager", azureKeyVaultSecretManagerFields)
+	}
+
+	flattenAzureKeyVaultSecretManager(d, sm)
+	d.Set("scope", flattenUsageScope(sm.UsageScope))
+
+	return nil
+}
+
+func resourceSecretManagerAzureKeyVaultUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	if _, err := client.UpdateAzureKeyVaultSecretManager(c, expandAzureKeyVaultSecretManager(d)); err != nil {
+		return harnessDiagnostics(err, "Unable to update Azure Key Vault secret manager", azureKeyVaultSecretManagerFields)
+	}
+
+	return resourceSecretManagerAzureKeyVaultRead(c, d, meta)
+}
+
+func resourceSecretManagerAzureKeyVaultDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteAzureKeyVaultSecretManager(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete Azure Key Vault secret manager", azureKeyVaultSecretManagerFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const azureKeyVaultClientSecret = `
+resource "harness_encrypted_secret" "client_secret" {
+  name              = "azure-client-secret"
+  value             = "secret"
+  secret_manager_id = "builtin"
+}
+`
+
+func TestResourceSecretManagerAzureKeyVault(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_secret_manager_azure_key_vault", "secretManager"),
+		resource.TestStep{
+			Config: azureKeyVaultClientSecret + `
+resource "harness_secret_manager_azure_key_vault" "vault" {
+  name             = "azure"
+  vault_name       = "harness-secrets"
+  subscription     = "subscription"
+  client_id        = "client"
+  tenant_id        = "tenant"
+  client_secret_id = harness_encrypted_secret.client_secret.id
+}
+
+data "harness_secret_manager_azure_key_vault" "vault" {
+  name = harness_secret_manager_azure_key_vault.vault.name
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_azure_key_vault.vault", "environment_type", "AZURE"),
+				resource.TestCheckResourceAttrPair("data.harness_secret_manager_azure_key_vault.vault", "id", "harness_secret_manager_azure_key_vault.vault", "id"),
+				resource.TestCheckResourceAttr("data.harness_secret_manager_azure_key_vault.vault", "vault_name", "harness-secrets"),
+			),
+		},
+		resource.TestStep{
+			Config: azureKeyVaultClientSecret + `
+resource "harness_secret_manager_azure_key_vault" "vault" {
+  name             = "azure"
+  vault_name       = "harness-secrets"
+  subscription     = "subscription"
+  client_id        = "other-client"
+  tenant_id        = "tenant"
+  client_secret_id = harness_encrypted_secret.client_secret.id
+  default          = true
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_azure_key_vault.vault", "default", "true"),
+				testCheckStored(server, "harness_secret_manager_azure_key_vault.vault", "secretManager", "clientId", "other-client"),
+			),
+		},
+		resource.TestStep{
+			Config: azureKeyVaultClientSecret + `
+resource "harness_secret_manager_azure_key_vault" "vault" {
+  name             = "azure"
+  vault_name       = "harness-secrets"
+  subscription     = "subscription"
+  client_id        = "other-client"
+  tenant_id        = "tenant"
+  client_secret_id = harness_encrypted_secret.client_secret.id
+
+  scope {
+    application_type = "ALL"
+    environment_type = "PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_azure_key_vault.vault", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_secret_manager_azure_key_vault.vault", "scope.0.environment_type", "PRODUCTION_ENVIRONMENTS"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_secret_manager_azure_key_vault.vault",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
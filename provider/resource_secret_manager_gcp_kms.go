@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gcpKMSSecretManagerFields maps GCP KMS secret manager input fields to their
+// attributes.
+var gcpKMSSecretManagerFields = map[string]string{
+	"name":                    "name",
+	"projectId":               "project_id",
+	"region":                  "region",
+	"keyRing":                 "key_ring",
+	"keyName":                 "key_name",
+	"credentialsFileSecretId": "credentials_file_secret_id",
+	"isDefault":               "default",
+	"usageScope":              "scope",
+}
+
+func resourceSecretManagerGCPKMS() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the GCP project of the key ring",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The location of the key ring, such as global or europe-west1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key_ring": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the key in the key ring the secrets are encrypted with",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"credentials_file_secret_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the encrypted file holding the JSON key of the service account",
+				Required:    true,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether new secrets are stored in this secret manager by default",
+				Optional:    true,
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceSecretManagerGCPKMSCreate,
+		ReadContext:   resourceSecretManagerGCPKMSRead,
+		UpdateContext: resourceSecretManagerGCPKMSUpdate,
+		DeleteContext: resourceSecretManagerGCPKMSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretManager,
+		},
+	}
+}
+
+func expandGCPKMSSecretManager(d *schema.ResourceData) *Harness.GCPKMSSecretManager {
+	return &Harness.GCPKMSSecretManager{
+		ID:                      d.Id(),
+		Name:                    d.Get("name").(string),
+		ProjectID:               d.Get("project_id").(string),
+		Region:                  d.Get("region").(string),
+		KeyRing:                 d.Get("key_ring").(string),
+		KeyName:                 d.Get("key_name").(string),
+		CredentialsFileSecretID: d.Get("credentials_file_secret_id").(string),
+		Default:                 d.Get("default").(bool),
+		UsageScope:              expandUsageScope(d),
+	}
+}
+
+func resourceSecretManagerGCPKMSCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.NewGCPKMSSecretManager(c, expandGCPKMSSecretManager(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create GCP KMS secret manager", gcpKMSSecretManagerFields)
+	}
+
+	d.SetId(sm.ID)
+
+	return resourceSecretManagerGCPKMSRead(c, d, meta)
+}
+
+func resourceSecretManagerGCPKMSRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.GetGCPKMSSecretManager(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read GCP KMS secret manager", gcpKMSSecretManagerFields)
+	}
+
+	d.Set("name", sm.Name)
+	d.Set("project_id", sm.ProjectID)
+	d.Set("region", sm.Region)
+	d.Set("key_ring", sm.KeyRing)
+	d.Set("key_name", sm.KeyName)
+	d.Set("credentials_file_secret_id", sm.CredentialsFileSecretID)
+	d.Set("default", sm.Default)
+	d.Set("scope", flattenUsageScope(sm.UsageScope))
+
+	return nil
+}
+
+func resourceSecretManagerGCPKMSUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	if _, err := client.UpdateGCPKMSSecretManager(c, expandGCPKMSSecretManager(d)); err != nil {
+		return harnessDiagnostics(err, "Unable to update GCP KMS secret manager", gcpKMSSecretManagerFields)
+	}
+
+	return resourceSecretManagerGCPKMSRead(c, d, meta)
+}
+
+func resourceSecretManagerGCPKMSDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteGCPKMSSecretManager(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete GCP KMS secret manager", gcpKMSSecretManagerFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
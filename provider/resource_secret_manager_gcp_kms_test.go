@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// gcpServiceAccountKey is an encrypted file holding the JSON key of a
+// service account of the my-project project.
+const gcpServiceAccountKey = `
+resource "harness_encrypted_file" "service_account_key" {
+  name              = "service-account.json"
+  content_base64    = "eyJ0eXBlIjoic2VydmljZV9hY2NvdW50IiwicHJvamVjdF9pZCI6Im15LXByb2plY3QifQ=="
+  secret_manager_id = "builtin"
+}
+`
+
+func TestResourceSecretManagerGCPKMS(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_secret_manager_gcp_kms", "secretManager"),
+		resource.TestStep{
+			Config: gcpServiceAccountKey + `
+resource "harness_secret_manager_gcp_kms" "kms" {
+  name                       = "gcp-kms"
+  project_id                 = "my-project"
+  region                     = "global"
+  key_ring                   = "harness"
+  key_name                   = "secrets"
+  credentials_file_secret_id = harness_encrypted_file.service_account_key.id
+}
+`,
+			Check: testCheckStored(server, "harness_secret_manager_gcp_kms.kms", "secretManager", "keyRing", "harness"),
+		},
+		resource.TestStep{
+			Config: gcpServiceAccountKey + `
+resource "harness_secret_manager_gcp_kms" "kms" {
+  name                       = "gcp-kms-renamed"
+  project_id                 = "my-project"
+  region                     = "global"
+  key_ring                   = "harness"
+  key_name                   = "secrets"
+  credentials_file_secret_id = harness_encrypted_file.service_account_key.id
+  default                    = true
+
+  scope {
+    application_type = "ALL"
+    environment_type = "PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_gcp_kms.kms", "default", "true"),
+				resource.TestCheckResourceAttr("harness_secret_manager_gcp_kms.kms", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_secret_manager_gcp_kms.kms", "scope.0.environment_type", "PRODUCTION_ENVIRONMENTS"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_secret_manager_gcp_kms.kms",
+			ImportState:       true,
+			ImportStateId:     "gcp-kms-renamed",
+			ImportStateVerify: true,
+		},
+	)
+}
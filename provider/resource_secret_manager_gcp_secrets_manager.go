@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gcpSecretsManagerFields maps GCP Secret Manager secret manager input fields
+// to their attributes.
+var gcpSecretsManagerFields = map[string]string{
+	"name":                    "name",
+	"credentialsFileSecretId": "credentials_file_secret_id",
+	"isDefault":               "default",
+	"usageScope":              "scope",
+}
+
+func resourceSecretManagerGCPSecretsManager() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"credentials_file_secret_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the encrypted file holding the JSON key of the service account",
+				Required:    true,
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the GCP project of the service account, which the secrets are stored in",
+				Computed:    true,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether new secrets are stored in this secret manager by default",
+				Optional:    true,
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceSecretManagerGCPSecretsManagerCreate,
+		ReadContext:   resourceSecretManagerGCPSecretsManagerRead,
+		UpdateContext: resourceSecretManagerGCPSecretsManagerUpdate,
+		DeleteContext: resourceSecretManagerGCPSecretsManagerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importSecretManager,
+		},
+	}
+}
+
+func expandGCPSecretsManager(d *schema.ResourceData) *Harness.GCPSecretsManager {
+	return &Harness.GCPSecretsManager{
+		ID:                      d.Id(),
+		Name:                    d.Get("name").(string),
+		CredentialsFileSecretID: d.Get("credentials_file_secret_id").(string),
+		Default:                 d.Get("default").(bool),
+		UsageScope:              expandUsageScope(d),
+	}
+}
+
+func resourceSecretManagerGCPSecretsManagerCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.NewGCPSecretsManager(c, expandGCPSecretsManager(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create GCP Secret Manager secret manager", gcpSecretsManagerFields)
+	}
+
+	d.SetId(sm.ID)
+
+	return resourceSecretManagerGCPSecretsManagerRead(c, d, meta)
+}
+
+func resourceSecretManagerGCPSecretsManagerRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.GetGCPSecretsManager(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read GCP Secret Manager secret manager", gcpSecretsManagerFields)
+	}
+
+	d.Set("name", sm.Name)
+	d.Set("credentials_file_secret_id", sm.CredentialsFileSecretID)
+	d.Set("project_id", sm.ProjectID)
+	d.Set("default", sm.Default)
+	d.Set("scope", flattenUsageScope(sm.UsageScope))
+
+	return nil
+}
+
+func resourceSecretManagerGCPSecretsManagerUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	if _, err := client.UpdateGCPSecretsManager(c, expandGCPSecretsManager(d)); err != nil {
+		return harnessDiagnostics(err, "Unable to update GCP Secret Manager secret manager", gcpSecretsManagerFields)
+	}
+
+	return resourceSecretManagerGCPSecretsManagerRead(c, d, meta)
+}
+
+func resourceSecretManagerGCPSecretsManagerDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteGCPSecretsManager(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete GCP Secret Manager secret manager", gcpSecretsManagerFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceSecretManagerGCPSecretsManager(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_secret_manager_gcp_secrets_manager", "secretManager"),
+		resource.TestStep{
+			Config: gcpServiceAccountKey + `
+resource "harness_secret_manager_gcp_secrets_manager" "gsm" {
+  name                       = "gsm"
+  credentials_file_secret_id = harness_encrypted_file.service_account_key.id
+}
+`,
+			Check: resource.TestCheckResourceAttr("harness_secret_manager_gcp_secrets_manager.gsm", "project_id", "my-project"),
+		},
+		resource.TestStep{
+			Config: gcpServiceAccountKey + `
+resource "harness_secret_manager_gcp_secrets_manager" "gsm" {
+  name                       = "gsm-renamed"
+  credentials_file_secret_id = harness_encrypted_file.service_account_key.id
+
+  scope {
+    application_type = "ALL"
+    environment_type = "NON_PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				testCheckStored(server, "harness_secret_manager_gcp_secrets_manager.gsm", "secretManager", "name", "gsm-renamed"),
+				resource.TestCheckResourceAttr("harness_secret_manager_gcp_secrets_manager.gsm", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_secret_manager_gcp_secrets_manager.gsm", "scope.0.environment_type", "NON_PRODUCTION_ENVIRONMENTS"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_secret_manager_gcp_secrets_manager.gsm",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// vaultSecretManagerFields maps Vault secret manager input fields to their
+// attributes.
+var vaultSecretManagerFields = map[string]string{
+	"name":                        "name",
+	"vaultUrl":                    "vault_url",
+	"basePath":                    "base_path",
+	"namespace":                   "namespace",
+	"authDetails":                 "auth_token",
+	"secretId":                    "app_role",
+	"secretEngineName":            "secret_engine_name",
+	"secretEngineVersion":         "secret_engine_version",
+	"secretEngineRenewalInterval": "renewal_interval_minutes",
+	"isReadOnly":                  "read_only",
+	"isDefault":                   "default",
+	"usageScope":                  "scope",
+}
+
+func resourceSecretManagerVault() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vault_url": {
+				Type:         schema.TypeString,
+				Description:  "The address of the Vault server, such as https://vault.example.com:8200",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"base_path": {
+				Type:        schema.TypeString,
+				Description: "The path under the secret engine the secrets are stored at",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The Vault Enterprise namespace of the secrets",
+				Optional:    true,
+			},
+			"auth_token": {
+				Type:         schema.TypeString,
+				Description:  "Authenticate with a Vault token",
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"auth_token", "app_role"},
+			},
+			"app_role": {
+				Type:         schema.TypeList,
+				Description:  "Authenticate with an AppRole",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"auth_token", "app_role"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"role_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"secret_id": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"secret_engine_name": {
+				Type:        schema.TypeString,
+				Description: "The path the KV secret engine is mounted at",
+				Optional:    true,
+				Default:     "secret",
+				ForceNew:    true,
+			},
+			"secret_engine_version": {
+				Type:         schema.TypeInt,
+				Description:  "The version of the KV secret engine, 1 or 2",
+				Optional:     true,
+				Default:      2,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 2}),
+			},
+			"renewal_interval_minutes": {
+				Type:         schema.TypeInt,
+				Description:  "How often the token is renewed, 0 to never renew it",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Description: "Whether Harness.io may only read existing secrets from Vault",
+				Optional:    true,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether new secrets are stored in this secret manager by default",
+				Optional:    true,
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceSecretManagerVaultCreate,
+		ReadContext:   resourceSecretManagerVaultRead,
+		UpdateContext: resourceSecretManagerVaultUpdate,
+		DeleteContext: resourceSecretManagerVaultDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandVaultSecretManager(d *schema.ResourceData) *Harness.VaultSecretManager {
+	v := &Harness.VaultSecretManager{
+		ID:                  d.Id(),
+		Name:                d.Get("name").(string),
+		VaultURL:            d.Get("vault_url").(string),
+		BasePath:            d.Get("base_path").(string),
+		Namespace:           d.Get("namespace").(string),
+		AuthToken:           d.Get("auth_token").(string),
+		SecretEngineName:    d.Get("secret_engine_name").(string),
+		SecretEngineVersion: d.Get("secret_engine_version").(int),
+		RenewalInterval:     d.Get("renewal_interval_minutes").(int),
+		ReadOnly:            d.Get("read_only").(bool),
+		Default:             d.Get("default").(bool),
+		UsageScope:          expandUsageScope(d),
+	}
+
+	if appRole := d.Get("app_role").([]interface{}); len(appRole) > 0 && appRole[0] != nil {
+		role := appRole[0].(map[string]interface{})
+		v.AppRoleID = role["role_id"].(string)
+		v.SecretID = role["secret_id"].(string)
+	}
+
+	return v
+}
+
+func resourceSecretManagerVaultCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.NewVaultSecretManager(c, expandVaultSecretManager(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create Vault secret manager", vaultSecretManagerFields)
+	}
+
+	d.SetId(sm.ID)
+
+	return resourceSecretManagerVaultRead(c, d, meta)
+}
+
+func resourceSecretManagerVaultRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	sm, err := client.GetVaultSecretManager(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read Vault secret manager", vaultSecretManagerFields)
+	}
+
+	d.Set("name", sm.Name)
+	d.Set("vault_url", sm.VaultURL)
+	d.Set("base_path", sm.BasePath)
+	d.Set("namespace", sm.Namespace)
+	d.Set("secret_engine_name", sm.SecretEngineName)
+	d.Set("secret_engine_version", sm.SecretEngineVersion)
+	d.Set("renewal_interval_minutes", sm.RenewalInterval)
+	d.Set("read_only", sm.ReadOnly)
+	d.Set("default", sm.Default)
+	d.Set("scope", flattenUsageScope(sm.UsageScope))
+
+	// The token and the secret id are never returned, only whether an
+	// AppRole is used and which.
+	if sm.AppRoleID == "" {
+		d.Set("app_role", nil)
+	} else {
+		d.Set("app_role", []interface{}{map[string]interface{}{
+			"role_id":   sm.AppRoleID,
+			"secret_id": d.Get("app_role.0.secret_id"),
+		}})
+	}
+
+	return nil
+}
+
+func resourceSecretManagerVaultUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	v := expandVaultSecretManager(d)
+	if !d.HasChanges("auth_token", "app_role") {
+		v.AuthToken, v.AppRoleID, v.SecretID = "", "", ""
+	}
+
+	if _, err := client.UpdateVaultSecretManager(c, v); err != nil {
+		return harnessDiagnostics(err, "Unable to update Vault secret manager", vaultSecretManagerFields)
+	}
+
+	return resourceSecretManagerVaultRead(c, d, meta)
+}
+
+func resourceSecretManagerVaultDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteVaultSecretManager(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete Vault secret manager", vaultSecretManagerFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
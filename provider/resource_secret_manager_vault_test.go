@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceSecretManagerVault(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_secret_manager_vault", "secretManager"),
+		resource.TestStep{
+			Config: `
+resource "harness_secret_manager_vault" "vault" {
+  name       = "vault"
+  vault_url  = "https://vault.example.com"
+  auth_token = "s.token"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_vault.vault", "secret_engine_name", "secret"),
+				resource.TestCheckResourceAttr("harness_secret_manager_vault.vault", "secret_engine_version", "2"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_secret_manager_vault" "vault" {
+  name                     = "vault"
+  vault_url                = "https://vault.example.com"
+  renewal_interval_minutes = 60
+
+  app_role {
+    role_id   = "role"
+    secret_id = "secret"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_vault.vault", "app_role.0.role_id", "role"),
+				resource.TestCheckResourceAttr("harness_secret_manager_vault.vault", "renewal_interval_minutes", "60"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_secret_manager_vault" "vault" {
+  name       = "vault"
+  vault_url  = "https://vault.example.com"
+  auth_token = "s.token"
+
+  scope {
+    application_type = "ALL"
+    environment_type = "NON_PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_secret_manager_vault.vault", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_secret_manager_vault.vault", "scope.0.application_type", "ALL"),
+				resource.TestCheckResourceAttr("harness_secret_manager_vault.vault", "scope.0.environment_type", "NON_PRODUCTION_ENVIRONMENTS"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_secret_manager_vault.vault",
+			ImportState:       true,
+			ImportStateVerify: true,
+			// Harness.io never returns how the secret manager authenticates
+			ImportStateVerifyIgnore: []string{"auth_token", "app_role"},
+		},
+	)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// serviceFields maps service input fields to their attributes.
+var serviceFields = map[string]string{
+	"applicationId":  "app_id",
+	"name":           "name",
+	"description":    "description",
+	"deploymentType": "deployment_type",
+	"artifactType":   "artifact_type",
+	"tags":           "tags",
+}
+
+func resourceService() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the application the service belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"deployment_type": {
+				Type:        schema.TypeString,
+				Description: "How the service is deployed, such as KUBERNETES, HELM, AZURE_WEBAPP, SSH or ECS",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(Harness.DeploymentTypeKubernetes),
+					string(Harness.DeploymentTypeHelm),
+					string(Harness.DeploymentTypeAzureWebapp),
+					string(Harness.DeploymentTypeSSH),
+					string(Harness.DeploymentTypeECS),
+					string(Harness.DeploymentTypeAWSLambda),
+					string(Harness.DeploymentTypeAMI),
+					string(Harness.DeploymentTypePCF),
+					string(Harness.DeploymentTypeWinRM),
+					string(Harness.DeploymentTypeAWSCodedeploy),
+					string(Harness.DeploymentTypeCustom),
+				}, false),
+			},
+			"artifact_type": {
+				Type:        schema.TypeString,
+				Description: "The kind of artifact the service deploys, such as DOCKER or JAR",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(Harness.ArtifactTypeDocker),
+					string(Harness.ArtifactTypeJar),
+					string(Harness.ArtifactTypeWar),
+					string(Harness.ArtifactTypeTar),
+					string(Harness.ArtifactTypeZip),
+					string(Harness.ArtifactTypeRpm),
+					string(Harness.ArtifactTypeNuget),
+					string(Harness.ArtifactTypeIIS),
+					string(Harness.ArtifactTypeOther),
+					string(Harness.ArtifactTypeAWSLambda),
+					string(Harness.ArtifactTypeAWSCodedeploy),
+					string(Harness.ArtifactTypePCF),
+					string(Harness.ArtifactTypeAMI),
+					string(Harness.ArtifactTypeAzureMachineImage),
+					string(Harness.ArtifactTypeAzureWebapp),
+				}, false),
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CreateContext: resourceServiceCreate,
+		ReadContext:   resourceServiceRead,
+		UpdateContext: resourceServiceUpdate,
+		DeleteContext: resourceServiceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importAppEntity,
+		},
+	}
+}
+
+func resourceServiceCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	svc := &Harness.Service{
+		ApplicationID:  d.Get("app_id").(string),
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		DeploymentType: Harness.DeploymentType(d.Get("deployment_type").(string)),
+		ArtifactType:   Harness.ArtifactType(d.Get("artifact_type").(string)),
+		Tags:           expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	svc, err := client.NewService(c, svc)
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create service", serviceFields)
+	}
+
+	d.SetId(svc.ID)
+	return resourceServiceRead(c, d, meta)
+}
+
+func resourceServiceRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	svc, err := client.GetService(c, d.Get("app_id").(string), d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read service", serviceFields)
+	}
+
+	d.Set("app_id", svc.ApplicationID)
+	d.Set("name", svc.Name)
+	d.Set("description", svc.Description)
+	d.Set("deployment_type", svc.DeploymentType)
+	d.Set("artifact_type", svc.ArtifactType)
+	d.Set("tags", flattenTags(svc.Tags))
+
+	return nil
+}
+
+func resourceServiceUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	svc := &Harness.Service{
+		ID:            d.Id(),
+		ApplicationID: d.Get("app_id").(string),
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		Tags:          expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	_, err := client.UpdateService(c, svc)
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update service", serviceFields)
+	}
+
+	return resourceServiceRead(c, d, meta)
+}
+
+func resourceServiceDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteService(c, d.Get("app_id").(string), d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete service", serviceFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
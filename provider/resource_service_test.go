@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceService(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_service", "service"),
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_service" "svc" {
+  app_id          = harness_application.app.id
+  name            = "svc"
+  deployment_type = "KUBERNETES"
+  artifact_type   = "DOCKER"
+  tags = {
+    team = "platform"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_service.svc", "artifact_type", "DOCKER"),
+				resource.TestCheckResourceAttr("harness_service.svc", "tags.team", "platform"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_service" "svc" {
+  app_id          = harness_application.app.id
+  name            = "svc"
+  description     = "the service"
+  deployment_type = "KUBERNETES"
+  artifact_type   = "DOCKER"
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_service.svc", "tags.%", "0"),
+				testCheckStored(server, "harness_service.svc", "service", "description", "the service"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_service.svc",
+			ImportState:       true,
+			ImportStateIdFunc: importAppEntityID("harness_service.svc"),
+			ImportStateVerify: true,
+		},
+	)
+}
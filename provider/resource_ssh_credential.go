@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// sshCredentialFields maps SSH credential input fields to their attributes.
+var sshCredentialFields = map[string]string{
+	"name":                    "name",
+	"sshAuthentication":       "ssh_authentication",
+	"sshAuthenticationMethod": "ssh_authentication",
+	"sshCredentialType":       "ssh_authentication",
+	"inlineSSHKey":            "ssh_authentication",
+	"sshKeySecretFileId":      "ssh_authentication",
+	"passphraseSecretId":      "ssh_authentication",
+	"serverPassword":          "ssh_authentication",
+	"kerberosAuthentication":  "kerberos_authentication",
+	"tgtGenerationMethod":     "kerberos_authentication",
+	"tgtGenerationUsing":      "kerberos_authentication",
+	"keyTabFile":              "kerberos_authentication",
+	"kerberosPassword":        "kerberos_authentication",
+	"usageScope":              "scope",
+}
+
+var sshAuthenticationMethods = []string{
+	"ssh_authentication.0.inline_ssh",
+	"ssh_authentication.0.server_password",
+}
+
+var tgtGenerationMethods = []string{
+	"kerberos_authentication.0.tgt_generation_method.0.key_tab_file_path",
+	"kerberos_authentication.0.tgt_generation_method.0.kerberos_password_id",
+}
+
+func resourceSSHCredential() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ssh_authentication": {
+				Type:         schema.TypeList,
+				Description:  "Authenticate over SSH with a key or a password",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"ssh_authentication", "kerberos_authentication"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      22,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"inline_ssh": {
+							Type:         schema.TypeList,
+							Description:  "Authenticate with a private key held in a secret",
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: sshAuthenticationMethods,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ssh_key_file_id": {
+										Type:        schema.TypeString,
+										Description: "The id of the secret holding the private key",
+										Required:    true,
+									},
+									"passphrase_secret_id": {
+										Type:        schema.TypeString,
+										Description: "The id of the encrypted text holding the passphrase of the key",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"server_password": {
+							Type:         schema.TypeList,
+							Description:  "Authenticate with a password held in an encrypted text",
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: sshAuthenticationMethods,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_secret_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kerberos_authentication": {
+				Type:         schema.TypeList,
+				Description:  "Authenticate with Kerberos",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"ssh_authentication", "kerberos_authentication"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      22,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"principal": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"realm": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"tgt_generation_method": {
+							Type:        schema.TypeList,
+							Description: "How the ticket granting ticket is obtained, leaving it to the delegates when not set",
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_tab_file_path": {
+										Type:         schema.TypeString,
+										Description:  "The path of the key tab file on the delegates",
+										Optional:     true,
+										ExactlyOneOf: tgtGenerationMethods,
+									},
+									"kerberos_password_id": {
+										Type:         schema.TypeString,
+										Description:  "The id of the encrypted text holding the password",
+										Optional:     true,
+										ExactlyOneOf: tgtGenerationMethods,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceSSHCredentialCreate,
+		ReadContext:   resourceSSHCredentialRead,
+		UpdateContext: resourceSSHCredentialUpdate,
+		DeleteContext: resourceSSHCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandSSHCredential(d *schema.ResourceData) *Harness.SSHCredentialSecret {
+	c := &Harness.SSHCredentialSecret{
+		ID:         d.Id(),
+		Name:       d.Get("name").(string),
+		UsageScope: expandUsageScope(d),
+	}
+
+	if auth := d.Get("ssh_authentication").([]interface{}); len(auth) > 0 {
+		ssh := auth[0].(map[string]interface{})
+		c.AuthenticationScheme = Harness.SSHAuthenticationSchemeSSH
+		c.Port = ssh["port"].(int)
+		c.UserName = ssh["username"].(string)
+
+		if key := ssh["inline_ssh"].([]interface{}); len(key) > 0 && key[0] != nil {
+			k := key[0].(map[string]interface{})
+			c.CredentialType = Harness.SSHCredentialTypeSSHKey
+			c.KeySecretID = k["ssh_key_file_id"].(string)
+			c.PassphraseSecretID = k["passphrase_secret_id"].(string)
+		}
+		if password := ssh["server_password"].([]interface{}); len(password) > 0 && password[0] != nil {
+			c.CredentialType = Harness.SSHCredentialTypePassword
+			c.PasswordSecretID = password[0].(map[string]interface{})["password_secret_id"].(string)
+		}
+
+		return c
+	}
+
+	kerberos := d.Get("kerberos_authentication").([]interface{})[0].(map[string]interface{})
+	c.AuthenticationScheme = Harness.SSHAuthenticationSchemeKerberos
+	c.Port = kerberos["port"].(int)
+	c.Principal = kerberos["principal"].(string)
+	c.Realm = kerberos["realm"].(string)
+
+	if method := kerberos["tgt_generation_method"].([]interface{}); len(method) > 0 && method[0] != nil {
+		m := method[0].(map[string]interface{})
+		if path := m["key_tab_file_path"].(string); path != "" {
+			c.TGTGenerationUsing = Harness.TGTGenerationUsingKeyTabFile
+			c.KeyTabFilePath = path
+		} else {
+			c.TGTGenerationUsing = Harness.TGTGenerationUsingPassword
+			c.PasswordSecretID = m["kerberos_password_id"].(string)
+		}
+	}
+
+	return c
+}
+
+// flattenSSHCredential sets what Harness.io returns of how c authenticates,
+// keeping the ids of the secrets it uses from the configuration as they are
+// never returned.
+func flattenSSHCredential(d *schema.ResourceData, c *Harness.SSHCredentialSecret) {
+	if c.AuthenticationScheme == Harness.SSHAuthenticationSchemeKerberos {
+		d.Set("ssh_authentication", nil)
+		d.Set("kerberos_authentication", []interface{}{map[string]interface{}{
+			"port":                  c.Port,
+			"principal":             c.Principal,
+			"realm":                 c.Realm,
+			"tgt_generation_method": d.Get("kerberos_authentication.0.tgt_generation_method"),
+		}})
+		return
+	}
+
+	d.Set("kerberos_authentication", nil)
+	d.Set("ssh_authentication", []interface{}{map[string]interface{}{
+		"port":            c.Port,
+		"username":        c.UserName,
+		"inline_ssh":      d.Get("ssh_authentication.0.inline_ssh"),
+		"server_password": d.Get("ssh_authentication.0.server_password"),
+	}})
+}
+
+func resourceSSHCredentialCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	credential, err := client.NewSSHCredential(c, expandSSHCredential(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create SSH credential", sshCredentialFields)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceSSHCredentialRead(c, d, meta)
+}
+
+func resourceSSHCredentialRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	credential, err := client.GetSSHCredential(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read SSH credential", sshCredentialFields)
+	}
+
+	d.Set("name", credential.Name)
+	d.Set("scope", flattenUsageScope(credential.UsageScope))
+	flattenSSHCredential(d, credential)
+
+	return nil
+}
+
+func resourceSSHCredentialUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	if _, err := client.UpdateSSHCredential(c, expandSSHCredential(d)); err != nil {
+		return harnessDiagnostics(err, "Unable to update SSH credential", sshCredentialFields)
+	}
+
+	return resourceSSHCredentialRead(c, d, meta)
+}
+
+func resourceSSHCredentialDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteSSHCredential(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete SSH credential", sshCredentialFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
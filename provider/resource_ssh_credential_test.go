@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const sshCredentialSecrets = `
+resource "harness_encrypted_secret" "password" {
+  name              = "ssh-password"
+  value             = "hunter2"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_encrypted_file" "key" {
+  name              = "ssh-key"
+  content_base64    = "a2V5"
+  secret_manager_id = "builtin"
+}
+`
+
+func TestResourceSSHCredential(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_ssh_credential", "secret"),
+		resource.TestStep{
+			Config: sshCredentialSecrets + `
+resource "harness_ssh_credential" "ssh" {
+  name = "ssh"
+
+  ssh_authentication {
+    username = "deploy"
+
+    server_password {
+      password_secret_id = harness_encrypted_secret.password.id
+    }
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_ssh_credential.ssh", "ssh_authentication.0.port", "22"),
+				resource.TestCheckResourceAttr("harness_ssh_credential.ssh", "ssh_authentication.0.inline_ssh.#", "0"),
+			),
+		},
+		resource.TestStep{
+			Config: sshCredentialSecrets + `
+resource "harness_ssh_credential" "ssh" {
+  name = "ssh"
+
+  ssh_authentication {
+    username = "deploy"
+    port     = 2222
+
+    inline_ssh {
+      ssh_key_file_id = harness_encrypted_file.key.id
+    }
+  }
+
+  scope {
+    application_type = "ALL"
+    environment_type = "PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_ssh_credential.ssh", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_ssh_credential.ssh", "scope.0.environment_type", "PRODUCTION_ENVIRONMENTS"),
+				resource.TestCheckResourceAttr("harness_ssh_credential.ssh", "ssh_authentication.0.port", "2222"),
+				resource.TestCheckResourceAttr("harness_ssh_credential.ssh", "ssh_authentication.0.server_password.#", "0"),
+				resource.TestCheckResourceAttrPair("harness_ssh_credential.ssh", "ssh_authentication.0.inline_ssh.0.ssh_key_file_id", "harness_encrypted_file.key", "id"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_ssh_credential.ssh",
+			ImportState:       true,
+			ImportStateVerify: true,
+			// Harness.io never returns the secrets a credential uses
+			ImportStateVerifyIgnore: []string{"ssh_authentication.0.inline_ssh", "ssh_authentication.0.server_password"},
+		},
+	)
+}
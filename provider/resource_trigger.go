@@ -0,0 +1,495 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// triggerFields maps trigger input fields to their attributes.
+var triggerFields = map[string]string{
+	"applicationId":          "app_id",
+	"name":                   "name",
+	"description":            "description",
+	"artifactConditionInput": "on_new_artifact",
+	"pipelineConditionInput": "on_pipeline_completion",
+	"scheduleConditionInput": "on_schedule",
+	"cronExpression":         "on_schedule",
+	"webhookConditionInput":  "on_webhook",
+	"webhookSourceType":      "on_webhook",
+	"githubEvent":            "on_webhook",
+	"gitlabEvent":            "on_webhook",
+	"bitbucketEvent":         "on_webhook",
+	"artifactSelections":     "artifact_selection",
+	"artifactSelectionType":  "artifact_selection",
+}
+
+// triggerConditions are the blocks configuring what starts a trigger, of
+// which exactly one must be set.
+var triggerConditions = []string{"on_new_artifact", "on_pipeline_completion", "on_schedule", "on_webhook"}
+
+// triggerTargets are the attributes naming what a trigger executes, of which
+// exactly one must be set.
+var triggerTargets = []string{"workflow_id", "pipeline_id"}
+
+func resourceTrigger() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the application the trigger belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"workflow_id": {
+				Type:         schema.TypeString,
+				Description:  "The id of the workflow the trigger executes",
+				Optional:     true,
+				ExactlyOneOf: triggerTargets,
+			},
+			"pipeline_id": {
+				Type:         schema.TypeString,
+				Description:  "The id of the pipeline the trigger executes",
+				Optional:     true,
+				ExactlyOneOf: triggerTargets,
+			},
+			"on_new_artifact": {
+				Type:         schema.TypeList,
+				Description:  "Executes when a new artifact is collected from an artifact source",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: triggerConditions,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"artifact_source_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"artifact_filter": {
+							Type:        schema.TypeString,
+							Description: "Only artifacts whose build number matches the filter start the trigger",
+							Optional:    true,
+						},
+						"regex": {
+							Type:        schema.TypeBool,
+							Description: "Whether artifact_filter is a regular expression",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"on_pipeline_completion": {
+				Type:         schema.TypeList,
+				Description:  "Executes when a pipeline completes successfully",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: triggerConditions,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pipeline_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"on_schedule": {
+				Type:         schema.TypeList,
+				Description:  "Executes on a cron schedule",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: triggerConditions,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cron_expression": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"on_new_artifact_only": {
+							Type:        schema.TypeBool,
+							Description: "Only execute when a new artifact was collected since the last execution",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"on_webhook": {
+				Type:         schema.TypeList,
+				Description:  "Executes when the webhook of the trigger is called",
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: triggerConditions,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:        schema.TypeString,
+							Description: "One of GITHUB, GITLAB, BITBUCKET or CUSTOM",
+							Required:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(Harness.WebhookSourceGithub),
+								string(Harness.WebhookSourceGitlab),
+								string(Harness.WebhookSourceBitbucket),
+								string(Harness.WebhookSourceCustom),
+							}, false),
+						},
+						"event": {
+							Type:        schema.TypeString,
+							Description: "The event of the Git provider starting the trigger, such as PUSH, required unless source is CUSTOM",
+							Optional:    true,
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Description: "The action of the GitHub event starting the trigger, such as OPENED",
+							Optional:    true,
+						},
+						"branch_regex": {
+							Type:        schema.TypeString,
+							Description: "Only events on branches matching the regular expression start the trigger",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"artifact_selection": {
+				Type:        schema.TypeList,
+				Description: "The artifact to deploy for each service",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(Harness.ArtifactSelectionTypeFromTriggeringArtifact),
+								string(Harness.ArtifactSelectionTypeFromTriggeringPipeline),
+								string(Harness.ArtifactSelectionTypeFromPayloadSource),
+								string(Harness.ArtifactSelectionTypeLastCollected),
+								string(Harness.ArtifactSelectionTypeLastDeployedWorkflow),
+								string(Harness.ArtifactSelectionTypeLastDeployedPipeline),
+							}, false),
+						},
+						"artifact_source_id": {
+							Type:        schema.TypeString,
+							Description: "Required for FROM_PAYLOAD_SOURCE and LAST_COLLECTED",
+							Optional:    true,
+						},
+						"artifact_filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"regex": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"workflow_id": {
+							Type:        schema.TypeString,
+							Description: "Required for LAST_DEPLOYED_WORKFLOW",
+							Optional:    true,
+						},
+						"pipeline_id": {
+							Type:        schema.TypeString,
+							Description: "Required for LAST_DEPLOYED_PIPELINE",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"variables": {
+				Type:        schema.TypeMap,
+				Description: "The values of the workflow variables, by name",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"exclude_hosts_with_same_artifact": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"webhook_url": {
+				Type:        schema.TypeString,
+				Description: "The URL to call to execute a webhook trigger",
+				Computed:    true,
+			},
+			"webhook_payload": {
+				Type:        schema.TypeString,
+				Description: "The body to post to the webhook of a CUSTOM webhook trigger",
+				Computed:    true,
+			},
+		},
+		CreateContext: resourceTriggerCreate,
+		ReadContext:   resourceTriggerRead,
+		UpdateContext: resourceTriggerUpdate,
+		DeleteContext: resourceTriggerDelete,
+		CustomizeDiff: customizeTriggerDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: importAppEntity,
+		},
+	}
+}
+
+// customizeTriggerDiff marks the webhook attributes as unknown when an update
+// changes them, so that they can be referenced in the same apply.
+func customizeTriggerDiff(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("on_webhook") {
+		if err := d.SetNewComputed("webhook_url"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("on_webhook") || d.HasChange("artifact_selection") || d.HasChange("variables") {
+		return d.SetNewComputed("webhook_payload")
+	}
+
+	return nil
+}
+
+func expandTrigger(d *schema.ResourceData) *Harness.Trigger {
+	return &Harness.Trigger{
+		ID:                           d.Id(),
+		ApplicationID:                d.Get("app_id").(string),
+		Name:                         d.Get("name").(string),
+		Description:                  d.Get("description").(string),
+		Condition:                    expandTriggerCondition(d),
+		Action:                       expandTriggerAction(d),
+		ExcludeHostsWithSameArtifact: d.Get("exclude_hosts_with_same_artifact").(bool),
+	}
+}
+
+func expandTriggerCondition(d *schema.ResourceData) *Harness.TriggerCondition {
+	if v, ok := d.GetOk("on_new_artifact.0"); ok {
+		c := v.(map[string]interface{})
+		return &Harness.TriggerCondition{
+			TriggerConditionType: Harness.TriggerConditionTypeNewArtifact,
+			ArtifactSourceID:     c["artifact_source_id"].(string),
+			ArtifactFilter:       c["artifact_filter"].(string),
+			Regex:                c["regex"].(bool),
+		}
+	}
+
+	if v, ok := d.GetOk("on_pipeline_completion.0"); ok {
+		c := v.(map[string]interface{})
+		return &Harness.TriggerCondition{
+			TriggerConditionType: Harness.TriggerConditionTypePipelineCompletion,
+			PipelineID:           c["pipeline_id"].(string),
+		}
+	}
+
+	if v, ok := d.GetOk("on_schedule.0"); ok {
+		c := v.(map[string]interface{})
+		return &Harness.TriggerCondition{
+			TriggerConditionType: Harness.TriggerConditionTypeScheduled,
+			CronExpression:       c["cron_expression"].(string),
+			OnNewArtifactOnly:    c["on_new_artifact_only"].(bool),
+		}
+	}
+
+	if v, ok := d.GetOk("on_webhook.0"); ok {
+		c := v.(map[string]interface{})
+		return &Harness.TriggerCondition{
+			TriggerConditionType: Harness.TriggerConditionTypeWebhook,
+			WebhookSource:        Harness.WebhookSource(c["source"].(string)),
+			WebhookEvent: &Harness.WebhookEvent{
+				Event:  c["event"].(string),
+				Action: c["action"].(string),
+			},
+			BranchRegex: c["branch_regex"].(string),
+		}
+	}
+
+	return nil
+}
+
+func expandTriggerAction(d *schema.ResourceData) *Harness.TriggerAction {
+	action := &Harness.TriggerAction{
+		WorkflowID:         d.Get("workflow_id").(string),
+		PipelineID:         d.Get("pipeline_id").(string),
+		ArtifactSelections: []*Harness.ArtifactSelection{},
+		Variables:          []*Harness.TriggerVariableValue{},
+	}
+
+	for _, v := range d.Get("artifact_selection").([]interface{}) {
+		s := v.(map[string]interface{})
+		selection := Harness.NewArtifactSelection(Harness.ArtifactSelectionType(s["type"].(string)), s["service_id"].(string))
+		selection.ArtifactSourceID = s["artifact_source_id"].(string)
+		selection.ArtifactFilter = s["artifact_filter"].(string)
+		selection.Regex = s["regex"].(bool)
+		selection.WorkflowID = s["workflow_id"].(string)
+		selection.PipelineID = s["pipeline_id"].(string)
+		action.ArtifactSelections = append(action.ArtifactSelections, selection)
+	}
+
+	for name, value := range d.Get("variables").(map[string]interface{}) {
+		action.Variables = append(action.Variables, &Harness.TriggerVariableValue{
+			Name:  name,
+			Value: value.(string),
+		})
+	}
+	sort.Slice(action.Variables, func(i, j int) bool {
+		return action.Variables[i].Name < action.Variables[j].Name
+	})
+
+	return action
+}
+
+func flattenTriggerCondition(d *schema.ResourceData, c *Harness.TriggerCondition) {
+	onNewArtifact := []interface{}{}
+	onPipelineCompletion := []interface{}{}
+	onSchedule := []interface{}{}
+	onWebhook := []interface{}{}
+	webhookURL, webhookPayload := "", ""
+
+	if c != nil {
+		switch c.TriggerConditionType {
+		case Harness.TriggerConditionTypeNewArtifact:
+			onNewArtifact = append(onNewArtifact, map[string]interface{}{
+				"artifact_source_id": c.ArtifactSourceID,
+				"artifact_filter":    c.ArtifactFilter,
+				"regex":              c.Regex,
+			})
+		case Harness.TriggerConditionTypePipelineCompletion:
+			onPipelineCompletion = append(onPipelineCompletion, map[string]interface{}{
+				"pipeline_id": c.PipelineID,
+			})
+		case Harness.TriggerConditionTypeScheduled:
+			onSchedule = append(onSchedule, map[string]interface{}{
+				"cron_expression":      c.CronExpression,
+				"on_new_artifact_only": c.OnNewArtifactOnly,
+			})
+		case Harness.TriggerConditionTypeWebhook:
+			webhook := map[string]interface{}{
+				"source":       string(c.WebhookSource),
+				"event":        "",
+				"action":       "",
+				"branch_regex": c.BranchRegex,
+			}
+			if c.WebhookEvent != nil {
+				webhook["event"] = c.WebhookEvent.Event
+				webhook["action"] = c.WebhookEvent.Action
+			}
+			onWebhook = append(onWebhook, webhook)
+
+			if c.WebhookDetails != nil {
+				webhookURL = c.WebhookDetails.WebhookURL
+				webhookPayload = c.WebhookDetails.Payload
+			}
+		}
+	}
+
+	d.Set("on_new_artifact", onNewArtifact)
+	d.Set("on_pipeline_completion", onPipelineCompletion)
+	d.Set("on_schedule", onSchedule)
+	d.Set("on_webhook", onWebhook)
+	d.Set("webhook_url", webhookURL)
+	d.Set("webhook_payload", webhookPayload)
+}
+
+func flattenTriggerAction(d *schema.ResourceData, a *Harness.TriggerAction) {
+	if a == nil {
+		a = &Harness.TriggerAction{}
+	}
+
+	selections := make([]interface{}, 0, len(a.ArtifactSelections))
+	for _, s := range a.ArtifactSelections {
+		selections = append(selections, map[string]interface{}{
+			"service_id":         s.ServiceID,
+			"type":               string(s.SelectionType()),
+			"artifact_source_id": s.ArtifactSourceID,
+			"artifact_filter":    s.ArtifactFilter,
+			"regex":              s.Regex,
+			"workflow_id":        s.WorkflowID,
+			"pipeline_id":        s.PipelineID,
+		})
+	}
+
+	variables := make(map[string]interface{}, len(a.Variables))
+	for _, v := range a.Variables {
+		variables[v.Name] = v.Value
+	}
+
+	d.Set("workflow_id", a.WorkflowID)
+	d.Set("pipeline_id", a.PipelineID)
+	d.Set("artifact_selection", selections)
+	d.Set("variables", variables)
+}
+
+func resourceTriggerCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	trigger, err := client.NewTrigger(c, expandTrigger(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create trigger", triggerFields)
+	}
+
+	d.SetId(trigger.ID)
+	return resourceTriggerRead(c, d, meta)
+}
+
+func resourceTriggerRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	trigger, err := client.GetTrigger(c, d.Get("app_id").(string), d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read trigger", triggerFields)
+	}
+
+	d.Set("app_id", trigger.ApplicationID)
+	d.Set("name", trigger.Name)
+	d.Set("description", trigger.Description)
+	d.Set("exclude_hosts_with_same_artifact", trigger.ExcludeHostsWithSameArtifact)
+	flattenTriggerCondition(d, trigger.Condition)
+	flattenTriggerAction(d, trigger.Action)
+
+	return nil
+}
+
+func resourceTriggerUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	_, err := client.UpdateTrigger(c, expandTrigger(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update trigger", triggerFields)
+	}
+
+	return resourceTriggerRead(c, d, meta)
+}
+
+func resourceTriggerDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteTrigger(c, d.Get("app_id").(string), d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete trigger", triggerFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceTrigger(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_trigger", "trigger"),
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_trigger" "deploy" {
+  app_id      = harness_application.app.id
+  name        = "deploy"
+  workflow_id = "workflow"
+
+  on_webhook {
+    source = "CUSTOM"
+  }
+
+  variables = {
+    env = "dev"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttrSet("harness_trigger.deploy", "webhook_url"),
+				resource.TestCheckResourceAttr("harness_trigger.deploy", "variables.env", "dev"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_trigger" "deploy" {
+  app_id      = harness_application.app.id
+  name        = "deploy"
+  workflow_id = "workflow"
+
+  on_schedule {
+    cron_expression = "0 0/15 * * * ?"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_trigger.deploy", "on_webhook.#", "0"),
+				resource.TestCheckResourceAttr("harness_trigger.deploy", "webhook_url", ""),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_trigger.deploy",
+			ImportState:       true,
+			ImportStateIdFunc: importAppEntityID("harness_trigger.deploy"),
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// userFields maps user input fields to their attributes.
+var userFields = map[string]string{
+	"name":         "name",
+	"email":        "email",
+	"userGroupIds": "user_group_ids",
+}
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address the invitation is sent to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_group_ids": {
+				Type:        schema.TypeSet,
+				Description: "The ids of the user groups the user is a member of. Conflicts with harness_user_group_membership: leave it unset on users added to groups that way and ignore its changes with lifecycle ignore_changes",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"invitation_status": {
+				Type:        schema.TypeString,
+				Description: "Either PENDING until the user accepts the invitation, or ACCEPTED",
+				Computed:    true,
+			},
+		},
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importUser,
+		},
+	}
+}
+
+// importUser imports a user by id or by email address.
+func importUser(c context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), "@") {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*Harness.Client)
+	user, err := client.GetUserByEmail(c, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(user.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandUser always sets the user groups of the user, so that an empty
+// user_group_ids removes them from every group.
+func expandUser(d *schema.ResourceData) *Harness.User {
+	user := &Harness.User{
+		ID:           d.Id(),
+		Name:         d.Get("name").(string),
+		Email:        d.Get("email").(string),
+		UserGroupIDs: []string{},
+	}
+
+	for _, id := range d.Get("user_group_ids").(*schema.Set).List() {
+		user.UserGroupIDs = append(user.UserGroupIDs, id.(string))
+	}
+
+	return user
+}
+
+func resourceUserCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	user, err := client.InviteUser(c, expandUser(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to invite user", userFields)
+	}
+
+	d.SetId(user.ID)
+	return resourceUserRead(c, d, meta)
+}
+
+func resourceUserRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	user, err := client.GetUser(c, d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read user", userFields)
+	}
+
+	d.Set("email", user.Email)
+	d.Set("name", user.Name)
+	d.Set("user_group_ids", user.UserGroupIDs)
+	d.Set("invitation_status", user.InvitationStatus)
+
+	return nil
+}
+
+func resourceUserUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	_, err := client.UpdateUser(c, expandUser(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update user", userFields)
+	}
+
+	return resourceUserRead(c, d, meta)
+}
+
+func resourceUserDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteUser(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete user", userFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
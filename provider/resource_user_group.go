@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// userGroupFields maps user group input fields to their attributes.
+var userGroupFields = map[string]string{
+	"name":                            "name",
+	"description":                     "description",
+	"accountPermissions":              "account_permissions",
+	"appPermissions":                  "app_permission",
+	"permissionType":                  "app_permission",
+	"applications":                    "app_permission",
+	"appIds":                          "app_permission",
+	"actions":                         "app_permission",
+	"notificationSettings":            "notification_settings",
+	"pagerDutyIntegrationKeySecretId": "notification_settings",
+}
+
+var accountPermissionTypes = []string{
+	string(Harness.AccountPermissionTypeAdministerOtherAccountFunctions),
+	string(Harness.AccountPermissionTypeCreateAndDeleteApplication),
+	string(Harness.AccountPermissionTypeManageAlertNotificationRules),
+	string(Harness.AccountPermissionTypeManageAPIKeys),
+	string(Harness.AccountPermissionTypeManageApplicationStacks),
+	string(Harness.AccountPermissionTypeManageAuthenticationSettings),
+	string(Harness.AccountPermissionTypeManageConfigAsCode),
+	string(Harness.AccountPermissionTypeManageCloudProviders),
+	string(Harness.AccountPermissionTypeManageConnectors),
+	string(Harness.AccountPermissionTypeManageDelegates),
+	string(Harness.AccountPermissionTypeManageDelegateProfiles),
+	string(Harness.AccountPermissionTypeManageDeploymentFreezes),
+	string(Harness.AccountPermissionTypeManageIpWhitelist),
+	string(Harness.AccountPermissionTypeManagePipelineGovernanceStandards),
+	string(Harness.AccountPermissionTypeManageSecrets),
+	string(Harness.AccountPermissionTypeManageSecretManagers),
+	string(Harness.AccountPermissionTypeManageTags),
+	string(Harness.AccountPermissionTypeManageTemplateLibrary),
+	string(Harness.AccountPermissionTypeManageUserAndUserGroupsAndAPIKeys),
+	string(Harness.AccountPermissionTypeViewAudits),
+	string(Harness.AccountPermissionTypeViewUserAndUserGroupsAndAPIKeys),
+}
+
+func resourceUserGroup() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"account_permissions": {
+				Type:        schema.TypeSet,
+				Description: "The permissions the group has across the account, such as MANAGE_SECRETS",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(accountPermissionTypes, false),
+				},
+			},
+			"app_permission": {
+				Type:        schema.TypeList,
+				Description: "The permissions the group has on the entities of applications",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_ids": {
+							Type:        schema.TypeSet,
+							Description: "The ids of the applications the permission applies to, all applications when not set",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"entity_type": {
+							Type:        schema.TypeString,
+							Description: "One of ALL, SERVICE, ENV, WORKFLOW, PIPELINE, DEPLOYMENT or PROVISIONER",
+							Required:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(Harness.AppPermissionTypeAll),
+								string(Harness.AppPermissionTypeService),
+								string(Harness.AppPermissionTypeEnv),
+								string(Harness.AppPermissionTypeWorkflow),
+								string(Harness.AppPermissionTypePipeline),
+								string(Harness.AppPermissionTypeDeployment),
+								string(Harness.AppPermissionTypeProvisioner),
+							}, false),
+						},
+						"actions": {
+							Type:        schema.TypeSet,
+							Description: "What the group may do with the entities, such as READ or EXECUTE_WORKFLOW",
+							Required:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(Harness.ActionsCreate),
+									string(Harness.ActionsRead),
+									string(Harness.ActionsUpdate),
+									string(Harness.ActionsDelete),
+									string(Harness.ActionsExecuteWorkflow),
+									string(Harness.ActionsExecutePipeline),
+									string(Harness.ActionsRollbackWorkflow),
+								}, false),
+							},
+						},
+					},
+				},
+			},
+			"notification_settings": {
+				Type:        schema.TypeList,
+				Description: "Where the notifications sent to the group go",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"send_to_members": {
+							Type:        schema.TypeBool,
+							Description: "Also notify each member of the group",
+							Optional:    true,
+						},
+						"send_mail_to_new_members": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"slack_channel_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"slack_webhook_url": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"pager_duty_integration_key_secret_id": {
+							Type:        schema.TypeString,
+							Description: "The id of the encrypted secret holding the PagerDuty integration key",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+		CreateContext: resourceUserGroupCreate,
+		ReadContext:   resourceUserGroupRead,
+		UpdateContext: resourceUserGroupUpdate,
+		DeleteContext: resourceUserGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandUserGroup(d *schema.ResourceData) *Harness.UserGroup {
+	group := &Harness.UserGroup{
+		ID:          d.Id(),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Permissions: &Harness.UserGroupPermissions{
+			AccountPermissions: &Harness.AccountPermissions{
+				AccountPermissionTypes: []Harness.AccountPermissionType{},
+			},
+			AppPermissions: []*Harness.ApplicationPermission{},
+		},
+		NotificationSettings: &Harness.NotificationSettings{
+			GroupEmailAddresses: []string{},
+		},
+	}
+
+	for _, p := range d.Get("account_permissions").(*schema.Set).List() {
+		group.Permissions.AccountPermissions.AccountPermissionTypes = append(group.Permissions.AccountPermissions.AccountPermissionTypes, Harness.AccountPermissionType(p.(string)))
+	}
+
+	for _, v := range d.Get("app_permission").([]interface{}) {
+		p := v.(map[string]interface{})
+		permission := &Harness.ApplicationPermission{
+			PermissionType: Harness.AppPermissionType(p["entity_type"].(string)),
+			Applications:   &Harness.AppFilter{},
+		}
+
+		for _, id := range p["app_ids"].(*schema.Set).List() {
+			permission.Applications.AppIDs = append(permission.Applications.AppIDs, id.(string))
+		}
+		if len(permission.Applications.AppIDs) == 0 {
+			permission.Applications.FilterType = Harness.FilterTypeAll
+		}
+
+		for _, action := range p["actions"].(*schema.Set).List() {
+			permission.Actions = append(permission.Actions, Harness.Actions(action.(string)))
+		}
+
+		group.Permissions.AppPermissions = append(group.Permissions.AppPermissions, permission)
+	}
+
+	if v := d.Get("notification_settings").([]interface{}); len(v) > 0 && v[0] != nil {
+		n := v[0].(map[string]interface{})
+		settings := group.NotificationSettings
+		settings.SendNotificationToMembers = n["send_to_members"].(bool)
+		settings.SendMailToNewMembers = n["send_mail_to_new_members"].(bool)
+		settings.SlackNotificationSetting = &Harness.SlackNotificationSetting{
+			SlackChannelName: n["slack_channel_name"].(string),
+			SlackWebhookURL:  n["slack_webhook_url"].(string),
+		}
+		settings.PagerDutyIntegrationKeySecretID = n["pager_duty_integration_key_secret_id"].(string)
+
+		for _, address := range n["email_addresses"].(*schema.Set).List() {
+			settings.GroupEmailAddresses = append(settings.GroupEmailAddresses, address.(string))
+		}
+	}
+
+	return group
+}
+
+func flattenUserGroupPermissions(d *schema.ResourceData, p *Harness.UserGroupPermissions) {
+	if p == nil {
+		p = &Harness.UserGroupPermissions{}
+	}
+
+	accountPermissions := []interface{}{}
+	if p.AccountPermissions != nil {
+		for _, t := range p.AccountPermissions.AccountPermissionTypes {
+			accountPermissions = append(accountPermissions, string(t))
+		}
+	}
+
+	appPermissions := make([]interface{}, 0, len(p.AppPermissions))
+	for _, permission := range p.AppPermissions {
+		appIDs := []interface{}{}
+		if permission.Applications != nil {
+			for _, id := range permission.Applications.AppIDs {
+				appIDs = append(appIDs, id)
+			}
+		}
+
+		actions := make([]interface{}, 0, len(permission.Actions))
+		for _, action := range permission.Actions {
+			actions = append(actions, string(action))
+		}
+
+		appPermissions = append(appPermissions, map[string]interface{}{
+			"app_ids":     appIDs,
+			"entity_type": string(permission.PermissionType),
+			"actions":     actions,
+		})
+	}
+
+	d.Set("account_permissions", accountPermissions)
+	d.Set("app_permission", appPermissions)
+}
+
+// flattenNotificationSettings only sets the notification_settings block when
+// some notification is configured, as Harness.io returns empty settings for
+// every group.
+func flattenNotificationSettings(d *schema.ResourceData, n *Harness.NotificationSettings) {
+	if n == nil {
+		n = &Harness.NotificationSettings{}
+	}
+
+	slack := n.SlackNotificationSetting
+	if slack == nil {
+		slack = &Harness.SlackNotificationSetting{}
+	}
+
+	if !n.SendNotificationToMembers && !n.SendMailToNewMembers && len(n.GroupEmailAddresses) == 0 &&
+		slack.SlackChannelName == "" && slack.SlackWebhookURL == "" && n.PagerDutyIntegrationKeySecretID == "" {
+		d.Set("notification_settings", []interface{}{})
+		return
+	}
+
+	d.Set("notification_settings", []interface{}{
+		map[string]interface{}{
+			"email_addresses":                      n.GroupEmailAddresses,
+			"send_to_members":                      n.SendNotificationToMembers,
+			"send_mail_to_new_members":             n.SendMailToNewMembers,
+			"slack_channel_name":                   slack.SlackChannelName,
+			"slack_webhook_url":                    slack.SlackWebhookURL,
+			"pager_duty_integration_key_secret_id": n.PagerDutyIntegrationKeySecretID,
+		},
+	})
+}
+
+func resourceUserGroupCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	group, err := client.NewUserGroup(c, expandUserGroup(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create user group", userGroupFields)
+	}
+
+	d.SetId(group.ID)
+	return resourceUserGroupRead(c, d, meta)
+}
+
+func resourceUserGroupRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	group, err := client.GetUserGroup(c, d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read user group", userGroupFields)
+	}
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	flattenUserGroupPermissions(d, group.Permissions)
+	flattenNotificationSettings(d, group.NotificationSettings)
+
+	return nil
+}
+
+func resourceUserGroupUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	_, err := client.UpdateUserGroup(c, expandUserGroup(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update user group", userGroupFields)
+	}
+
+	return resourceUserGroupRead(c, d, meta)
+}
+
+func resourceUserGroupDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteUserGroup(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete user group", userGroupFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
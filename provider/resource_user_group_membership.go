@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// userGroupMembershipFields maps membership input fields to their
+// attributes.
+var userGroupMembershipFields = map[string]string{
+	"userId":      "user_ids",
+	"userGroupId": "user_group_id",
+}
+
+// resourceUserGroupMembership manages the complete member list of a user
+// group, so that members added outside of Terraform show up as drift.
+func resourceUserGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"user_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_ids": {
+				Type:        schema.TypeSet,
+				Description: "The ids of every member of the user group, members not listed are removed. Ignore changes to the user_group_ids of these users",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CreateContext: resourceUserGroupMembershipCreate,
+		ReadContext:   resourceUserGroupMembershipRead,
+		UpdateContext: resourceUserGroupMembershipUpdate,
+		DeleteContext: resourceUserGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// reconcileMembers adds and removes members of the user group until its
+// members are exactly userIDs.
+func reconcileMembers(c context.Context, client *Harness.Client, userGroupID string, userIDs *schema.Set) error {
+	current, err := client.GetUserGroupMembers(c, userGroupID)
+	if err != nil {
+		return err
+	}
+
+	members := schema.NewSet(userIDs.F, nil)
+	for _, id := range current {
+		members.Add(id)
+	}
+
+	for _, id := range userIDs.Difference(members).List() {
+		if err := client.AddUserToUserGroup(c, id.(string), userGroupID); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range members.Difference(userIDs).List() {
+		if err := client.RemoveUserFromUserGroup(c, id.(string), userGroupID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceUserGroupMembershipCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	userGroupID := d.Get("user_group_id").(string)
+
+	err := reconcileMembers(c, client, userGroupID, d.Get("user_ids").(*schema.Set))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to set user group members", userGroupMembershipFields)
+	}
+
+	d.SetId(userGroupID)
+	return resourceUserGroupMembershipRead(c, d, meta)
+}
+
+func resourceUserGroupMembershipRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	_, err := client.GetUserGroup(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read user group members", userGroupMembershipFields)
+	}
+
+	members, err := client.GetUserGroupMembers(c, d.Id())
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read user group members", userGroupMembershipFields)
+	}
+
+	d.Set("user_group_id", d.Id())
+	d.Set("user_ids", members)
+
+	return nil
+}
+
+func resourceUserGroupMembershipUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := reconcileMembers(c, client, d.Id(), d.Get("user_ids").(*schema.Set))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to set user group members", userGroupMembershipFields)
+	}
+
+	return resourceUserGroupMembershipRead(c, d, meta)
+}
+
+func resourceUserGroupMembershipDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	for _, id := range d.Get("user_ids").(*schema.Set).List() {
+		err := client.RemoveUserFromUserGroup(c, id.(string), d.Id())
+		if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+			return harnessDiagnostics(err, "Unable to remove user group members", userGroupMembershipFields)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
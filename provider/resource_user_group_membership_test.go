@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const userGroupMembershipUsers = `
+resource "harness_user_group" "developers" {
+  name = "developers"
+}
+
+resource "harness_user" "jane" {
+  name  = "Jane"
+  email = "jane@example.com"
+
+  lifecycle {
+    ignore_changes = [user_group_ids]
+  }
+}
+
+resource "harness_user" "john" {
+  name  = "John"
+  email = "john@example.com"
+
+  lifecycle {
+    ignore_changes = [user_group_ids]
+  }
+}
+`
+
+func TestResourceUserGroupMembership(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckUserGroupMembershipDestroyed(server),
+		resource.TestStep{
+			Config: userGroupMembershipUsers + `
+resource "harness_user_group_membership" "developers" {
+  user_group_id = harness_user_group.developers.id
+  user_ids      = [harness_user.jane.id, harness_user.john.id]
+}
+`,
+			Check: resource.TestCheckResourceAttr("harness_user_group_membership.developers", "user_ids.#", "2"),
+		},
+		resource.TestStep{
+			Config: userGroupMembershipUsers + `
+resource "harness_user_group_membership" "developers" {
+  user_group_id = harness_user_group.developers.id
+  user_ids      = [harness_user.john.id]
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_user_group_membership.developers", "user_ids.#", "1"),
+				testCheckUserGroupMembers(server, "harness_user_group_membership.developers", 1),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_user_group_membership.developers",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
+
+func testCheckUserGroupMembers(server *harnesstest.Server, name string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+
+		members, err := server.Client().GetUserGroupMembers(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(members) != count {
+			return fmt.Errorf("user group %s has members %v, want %d", rs.Primary.ID, members, count)
+		}
+		return nil
+	}
+}
+
+// testCheckUserGroupMembershipDestroyed checks that the users of the
+// membership were deleted along with it, which also took them out of the
+// group.
+func testCheckUserGroupMembershipDestroyed(server *harnesstest.Server) resource.TestCheckFunc {
+	return testCheckDestroyed(server, "harness_user", "user")
+}
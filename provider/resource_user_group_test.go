@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceUserGroup(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_user_group", "userGroup"),
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_user_group" "developers" {
+  name                = "developers"
+  account_permissions = ["VIEW_AUDITS"]
+
+  app_permission {
+    entity_type = "SERVICE"
+    app_ids     = [harness_application.app.id]
+    actions     = ["READ", "UPDATE"]
+  }
+
+  notification_settings {
+    email_addresses = ["developers@example.com"]
+    send_to_members = true
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_user_group.developers", "app_permission.0.actions.#", "2"),
+				resource.TestCheckResourceAttr("harness_user_group.developers", "notification_settings.0.send_to_members", "true"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_application" "app" {
+  name = "app"
+}
+
+resource "harness_user_group" "developers" {
+  name                = "developers"
+  description         = "Everyone writing code"
+  account_permissions = ["VIEW_AUDITS", "MANAGE_SECRETS"]
+
+  app_permission {
+    entity_type = "ALL"
+    actions     = ["READ"]
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_user_group.developers", "account_permissions.#", "2"),
+				resource.TestCheckResourceAttr("harness_user_group.developers", "app_permission.0.app_ids.#", "0"),
+				resource.TestCheckResourceAttr("harness_user_group.developers", "notification_settings.#", "0"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_user_group.developers",
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+	)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceUser(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_user", "user"),
+		resource.TestStep{
+			Config: `
+resource "harness_user_group" "developers" {
+  name = "developers"
+}
+
+resource "harness_user" "jane" {
+  name           = "Jane"
+  email          = "jane@example.com"
+  user_group_ids = [harness_user_group.developers.id]
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_user.jane", "invitation_status", "PENDING"),
+				resource.TestCheckResourceAttr("harness_user.jane", "user_group_ids.#", "1"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_user_group" "developers" {
+  name = "developers"
+}
+
+resource "harness_user" "jane" {
+  name           = "Jane Doe"
+  email          = "jane@example.com"
+  user_group_ids = []
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_user.jane", "name", "Jane Doe"),
+				resource.TestCheckResourceAttr("harness_user.jane", "user_group_ids.#", "0"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_user.jane",
+			ImportState:       true,
+			ImportStateId:     "jane@example.com",
+			ImportStateVerify: true,
+		},
+	)
+}
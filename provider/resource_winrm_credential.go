@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// winRMCredentialFields maps WinRM credential input fields to their
+// attributes.
+var winRMCredentialFields = map[string]string{
+	"name":             "name",
+	"domain":           "domain",
+	"userName":         "username",
+	"passwordSecretId": "password_secret_id",
+	"useSSL":           "use_ssl",
+	"skipCertCheck":    "skip_cert_check",
+	"port":             "port",
+	"usageScope":       "scope",
+}
+
+func resourceWinRMCredential() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The Active Directory domain of the user",
+				Optional:    true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password_secret_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the encrypted text holding the password of the user",
+				Required:    true,
+			},
+			"use_ssl": {
+				Type:        schema.TypeBool,
+				Description: "Whether to connect over HTTPS",
+				Optional:    true,
+				Default:     true,
+			},
+			"skip_cert_check": {
+				Type:        schema.TypeBool,
+				Description: "Whether to skip checking the certificate of the hosts when connecting over HTTPS",
+				Optional:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5986,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"scope": usageScopeSchema(),
+		},
+		CreateContext: resourceWinRMCredentialCreate,
+		ReadContext:   resourceWinRMCredentialRead,
+		UpdateContext: resourceWinRMCredentialUpdate,
+		DeleteContext: resourceWinRMCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandWinRMCredential(d *schema.ResourceData) *Harness.WinRMCredentialSecret {
+	return &Harness.WinRMCredentialSecret{
+		ID:               d.Id(),
+		Name:             d.Get("name").(string),
+		Domain:           d.Get("domain").(string),
+		UserName:         d.Get("username").(string),
+		PasswordSecretID: d.Get("password_secret_id").(string),
+		UseSSL:           d.Get("use_ssl").(bool),
+		SkipCertCheck:    d.Get("skip_cert_check").(bool),
+		Port:             d.Get("port").(int),
+		UsageScope:       expandUsageScope(d),
+	}
+}
+
+func resourceWinRMCredentialCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	credential, err := client.NewWinRMCredential(c, expandWinRMCredential(d))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create WinRM credential", winRMCredentialFields)
+	}
+
+	d.SetId(credential.ID)
+
+	return resourceWinRMCredentialRead(c, d, meta)
+}
+
+func resourceWinRMCredentialRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	credential, err := client.GetWinRMCredential(c, d.Id())
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read WinRM credential", winRMCredentialFields)
+	}
+
+	d.Set("name", credential.Name)
+	d.Set("domain", credential.Domain)
+	d.Set("username", credential.UserName)
+	d.Set("use_ssl", credential.UseSSL)
+	d.Set("skip_cert_check", credential.SkipCertCheck)
+	d.Set("port", credential.Port)
+	d.Set("scope", flattenUsageScope(credential.UsageScope))
+
+	return nil
+}
+
+func resourceWinRMCredentialUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	if _, err := client.UpdateWinRMCredential(c, expandWinRMCredential(d)); err != nil {
+		return harnessDiagnostics(err, "Unable to update WinRM credential", winRMCredentialFields)
+	}
+
+	return resourceWinRMCredentialRead(c, d, meta)
+}
+
+func resourceWinRMCredentialDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.DeleteWinRMCredential(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete WinRM credential", winRMCredentialFields)
+	}
+
+	d.SetId("")
+
+	return nil
+}
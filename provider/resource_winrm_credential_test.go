@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestResourceWinRMCredential(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckDestroyed(server, "harness_winrm_credential", "secret"),
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "password" {
+  name              = "winrm-password"
+  value             = "hunter2"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_winrm_credential" "winrm" {
+  name               = "winrm"
+  username           = "Administrator"
+  password_secret_id = harness_encrypted_secret.password.id
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_winrm_credential.winrm", "port", "5986"),
+				resource.TestCheckResourceAttr("harness_winrm_credential.winrm", "use_ssl", "true"),
+			),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_encrypted_secret" "password" {
+  name              = "winrm-password"
+  value             = "hunter2"
+  secret_manager_id = "builtin"
+}
+
+resource "harness_winrm_credential" "winrm" {
+  name               = "winrm"
+  username           = "Administrator"
+  domain             = "example.com"
+  password_secret_id = harness_encrypted_secret.password.id
+  use_ssl            = false
+  port               = 5985
+
+  scope {
+    application_type = "ALL"
+    environment_type = "NON_PRODUCTION_ENVIRONMENTS"
+  }
+}
+`,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("harness_winrm_credential.winrm", "scope.#", "1"),
+				resource.TestCheckResourceAttr("harness_winrm_credential.winrm", "scope.0.environment_type", "NON_PRODUCTION_ENVIRONMENTS"),
+				resource.TestCheckResourceAttr("harness_winrm_credential.winrm", "port", "5985"),
+				resource.TestCheckResourceAttr("harness_winrm_credential.winrm", "domain", "example.com"),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      "harness_winrm_credential.winrm",
+			ImportState:       true,
+			ImportStateVerify: true,
+			// Harness.io never returns the secrets a credential uses
+			ImportStateVerifyIgnore: []string{"password_secret_id"},
+		},
+	)
+}
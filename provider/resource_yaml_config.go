@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v2"
+)
+
+func resourceYAMLConfig() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Description: "The path of the entity in the Harness.io YAML tree, such as Setup/Applications/my-app/Workflows/deploy.yaml",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^Setup/.+\.yaml$`),
+					"must be a path in the Harness.io YAML tree, starting with Setup/ and ending with .yaml",
+				),
+			},
+			"content": {
+				Type:             schema.TypeString,
+				Description:      "The YAML describing the entity",
+				Required:         true,
+				ValidateFunc:     validateYAML,
+				DiffSuppressFunc: suppressEquivalentYAML,
+			},
+		},
+		CreateContext: resourceYAMLConfigCreate,
+		ReadContext:   resourceYAMLConfigRead,
+		UpdateContext: resourceYAMLConfigUpdate,
+		DeleteContext: resourceYAMLConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func validateYAML(v interface{}, k string) ([]string, []error) {
+	var doc interface{}
+	if err := yaml.Unmarshal([]byte(v.(string)), &doc); err != nil {
+		return nil, []error{fmt.Errorf("%s is not valid YAML: %v", k, err)}
+	}
+	return nil, nil
+}
+
+// equivalentYAML reports whether two YAML documents describe the same thing,
+// ignoring formatting, comments and the order of keys.
+func equivalentYAML(a string, b string) bool {
+	var docA, docB interface{}
+	if err := yaml.Unmarshal([]byte(a), &docA); err != nil {
+		return a == b
+	}
+	if err := yaml.Unmarshal([]byte(b), &docB); err != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(docA, docB)
+}
+
+func suppressEquivalentYAML(k string, old string, new string, d *schema.ResourceData) bool {
+	return equivalentYAML(old, new)
+}
+
+func resourceYAMLConfigCreate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	path := d.Get("path").(string)
+
+	err := client.YAML().Upsert(c, path, d.Get("content").(string))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to create YAML config", nil)
+	}
+
+	d.SetId(path)
+	return resourceYAMLConfigRead(c, d, meta)
+}
+
+func resourceYAMLConfigRead(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+	content, err := client.YAML().Get(c, d.Id())
+
+	if errors.Is(err, Harness.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to read YAML config", nil)
+	}
+
+	d.Set("path", d.Id())
+
+	// Harness.io renders the YAML itself, so keep the configured content
+	// unless it actually changed.
+	if !equivalentYAML(d.Get("content").(string), content) {
+		d.Set("content", content)
+	}
+
+	return nil
+}
+
+func resourceYAMLConfigUpdate(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.YAML().Upsert(c, d.Id(), d.Get("content").(string))
+	if err != nil {
+		return harnessDiagnostics(err, "Unable to update YAML config", nil)
+	}
+
+	return resourceYAMLConfigRead(c, d, meta)
+}
+
+func resourceYAMLConfigDelete(c context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Harness.Client)
+
+	err := client.YAML().Delete(c, d.Id())
+	if err != nil && !errors.Is(err, Harness.ErrNotFound) {
+		return harnessDiagnostics(err, "Unable to delete YAML config", nil)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/eu-evops/terraform-provider-harness/harness/harnesstest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceYAMLConfig(t *testing.T) {
+	server := harnesstest.NewServer()
+	defer server.Close()
+
+	unitTest(t, server, testCheckYAMLDestroyed(server),
+		resource.TestStep{
+			Config: `
+resource "harness_yaml_config" "workflow" {
+  path    = "Setup/Applications/app/Workflows/deploy.yaml"
+  content = <<EOT
+harnessApiVersion: '1.0'
+type: CANARY
+EOT
+}
+`,
+			Check: resource.TestCheckResourceAttr("harness_yaml_config.workflow", "id", "Setup/Applications/app/Workflows/deploy.yaml"),
+		},
+		resource.TestStep{
+			Config: `
+resource "harness_yaml_config" "workflow" {
+  path    = "Setup/Applications/app/Workflows/deploy.yaml"
+  content = <<EOT
+harnessApiVersion: '1.0'
+type: ROLLING
+EOT
+}
+`,
+		},
+		resource.TestStep{
+			ResourceName:      "harness_yaml_config.workflow",
+			ImportState:       true,
+			ImportStateVerify: true,
+			// Harness.io returns the content reformatted
+			ImportStateVerifyIgnore: []string{"content"},
+		},
+	)
+}
+
+func testCheckYAMLDestroyed(server *harnesstest.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "harness_yaml_config" {
+				continue
+			}
+			if _, ok := server.YAML(rs.Primary.ID); ok {
+				return fmt.Errorf("YAML config %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// awsSecretManagerSchema adds the attributes shared by the AWS secret
+// managers to attributes. Without an access_key or an assume_sts_role block,
+// the secret manager uses the IAM role of the delegates matching
+// delegate_selectors.
+func awsSecretManagerSchema(attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	attributes["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	attributes["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The AWS region the secrets are stored in, such as us-east-1",
+		Required:    true,
+		ForceNew:    true,
+	}
+	attributes["access_key"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Description:   "Authenticate with the access key of an IAM user",
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"assume_sts_role"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"access_key_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"secret_key_secret_id": {
+					Type:        schema.TypeString,
+					Description: "The id of the encrypted text holding the secret access key",
+					Required:    true,
+				},
+			},
+		},
+	}
+	attributes["assume_sts_role"] = &schema.Schema{
+		Type:          schema.TypeList,
+		Description:   "Assume an IAM role through STS from the delegates matching delegate_selectors",
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"access_key"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"external_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Description:  "How long the assumed role is used for",
+					Optional:     true,
+					Default:      900,
+					ValidateFunc: validation.IntBetween(900, 43200),
+				},
+			},
+		},
+	}
+	attributes["delegate_selectors"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: "The selectors of the delegates talking to AWS, required unless authenticating with an access key",
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+	attributes["default"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether new secrets are stored in this secret manager by default",
+		Optional:    true,
+	}
+	attributes["scope"] = usageScopeSchema()
+
+	return attributes
+}
+
+// customizeAWSSecretManagerDiff requires delegate_selectors unless the secret
+// manager authenticates with an access key, as Harness.io otherwise has no
+// delegate to take the IAM role of.
+func customizeAWSSecretManagerDiff(c context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("delegate_selectors") || !d.NewValueKnown("access_key") {
+		return nil
+	}
+
+	if accessKey := d.Get("access_key").([]interface{}); len(accessKey) > 0 {
+		return nil
+	}
+
+	if d.Get("delegate_selectors").(*schema.Set).Len() == 0 {
+		return errors.New("delegate_selectors is required unless authenticating with an access_key")
+	}
+
+	return nil
+}
+
+func expandAWSSecretManagerCredentials(d *schema.ResourceData) *Harness.AwsSecretManagerCredentials {
+	if accessKey := d.Get("access_key").([]interface{}); len(accessKey) > 0 && accessKey[0] != nil {
+		key := accessKey[0].(map[string]interface{})
+		return &Harness.AwsSecretManagerCredentials{
+			CredentialType:    Harness.AwsSecretManagerCredentialTypeAccessKey,
+			AccessKey:         key["access_key_id"].(string),
+			SecretKeySecretID: key["secret_key_secret_id"].(string),
+		}
+	}
+
+	if assumeRole := d.Get("assume_sts_role").([]interface{}); len(assumeRole) > 0 && assumeRole[0] != nil {
+		role := assumeRole[0].(map[string]interface{})
+		return &Harness.AwsSecretManagerCredentials{
+			CredentialType:        Harness.AwsSecretManagerCredentialTypeAssumeSTSRole,
+			RoleARN:               role["role_arn"].(string),
+			ExternalID:            role["external_id"].(string),
+			AssumeSTSRoleDuration: role["duration_seconds"].(int),
+		}
+	}
+
+	return &Harness.AwsSecretManagerCredentials{
+		CredentialType: Harness.AwsSecretManagerCredentialTypeAssumeIAMRole,
+	}
+}
+
+// expandDelegateSelectors never returns nil, so that removing every selector
+// clears them rather than keeping the previous ones.
+func expandDelegateSelectors(d *schema.ResourceData) []string {
+	selectors := []string{}
+	for _, selector := range d.Get("delegate_selectors").(*schema.Set).List() {
+		selectors = append(selectors, selector.(string))
+	}
+	sort.Strings(selectors)
+
+	return selectors
+}
+
+// flattenAWSSecretManager sets the attributes shared by the AWS secret
+// managers.
+func flattenAWSSecretManager(d *schema.ResourceData, name, region string, credentials *Harness.AwsSecretManagerCredentials, selectors []string, isDefault bool, usageScope *Harness.UsageScope) {
+	d.Set("name", name)
+	d.Set("region", region)
+	d.Set("delegate_selectors", selectors)
+	d.Set("default", isDefault)
+	d.Set("scope", flattenUsageScope(usageScope))
+
+	d.Set("access_key", nil)
+	d.Set("assume_sts_role", nil)
+	if credentials == nil {
+		return
+	}
+
+	switch credentials.CredentialType {
+	case Harness.AwsSecretManagerCredentialTypeAccessKey:
+		d.Set("access_key", []interface{}{map[string]interface{}{
+			"access_key_id":        credentials.AccessKey,
+			"secret_key_secret_id": credentials.SecretKeySecretID,
+		}})
+	case Harness.AwsSecretManagerCredentialTypeAssumeSTSRole:
+		d.Set("assume_sts_role", []interface{}{map[string]interface{}{
+			"role_arn":         credentials.RoleARN,
+			"external_id":      credentials.ExternalID,
+			"duration_seconds": credentials.AssumeSTSRoleDuration,
+		}})
+	}
+}
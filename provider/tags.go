@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"sort"
+
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+)
+
+func expandTags(tags map[string]interface{}) []*Harness.Tag {
+	names := make([]string, 0, len(tags))
+	for name := range tags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]*Harness.Tag, 0, len(names))
+	for _, name := range names {
+		out = append(out, &Harness.Tag{Name: name, Value: tags[name].(string)})
+	}
+	return out
+}
+
+func flattenTags(tags []*Harness.Tag) map[string]interface{} {
+	out := make(map[string]interface{}, len(tags))
+	for _, tag := range tags {
+		out[tag.Name] = tag.Value
+	}
+	return out
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	Harness "github.com/eu-evops/terraform-provider-harness/harness"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// usageScopeSchema is the scope block restricting the applications and
+// environments allowed to use a secret or a connector.
+func usageScopeSchema() *schema.Schema {
+	return &schema.Schema{
+		Optional: true,
+		Type:     schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"application_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"application_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"environment_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"environment_type": {
+					Type:        schema.TypeString,
+					Description: "Either NON_PRODUCTION_ENVIRONMENTS or PRODUCTION_ENVIRONMENTS",
+					Optional:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"NON_PRODUCTION_ENVIRONMENTS",
+						"PRODUCTION_ENVIRONMENTS",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+// expandUsageScope returns the usage scope of the scope blocks, which is
+// empty when the entity is scoped to the account. Resources without a
+// scoped_to_account attribute always use their scope blocks.
+func expandUsageScope(d *schema.ResourceData) *Harness.UsageScope {
+	usageScope := &Harness.UsageScope{
+		AppEnvScopes: make([]*Harness.AppEnvScope, 0),
+	}
+
+	if scopedToAccount, _ := d.Get("scoped_to_account").(bool); scopedToAccount {
+		return usageScope
+	}
+
+	for _, scope := range d.Get("scope").([]interface{}) {
+		s := scope.(map[string]interface{})
+		usageScope.AppEnvScopes = append(usageScope.AppEnvScopes, &Harness.AppEnvScope{
+			Application: &Harness.AppScopeFilter{
+				AppID:      s["application_id"].(string),
+				FilterType: Harness.FilterType(s["application_type"].(string)),
+			},
+			Environment: &Harness.EnvScopeFilter{
+				EnvID:      s["environment_id"].(string),
+				FilterType: Harness.EnvFilterType(s["environment_type"].(string)),
+			},
+		})
+	}
+
+	return usageScope
+}
+
+// flattenUsageScope returns the scope blocks of a usage scope read from
+// Harness.io.
+func flattenUsageScope(u *Harness.UsageScope) []interface{} {
+	if u == nil {
+		return nil
+	}
+
+	scopes := make([]interface{}, 0, len(u.AppEnvScopes))
+	for _, scope := range u.AppEnvScopes {
+		s := map[string]interface{}{}
+		if scope.Application != nil {
+			s["application_id"] = scope.Application.AppID
+			s["application_type"] = string(scope.Application.FilterType)
+		}
+		if scope.Environment != nil {
+			s["environment_id"] = scope.Environment.EnvID
+			s["environment_type"] = string(scope.Environment.FilterType)
+		}
+		scopes = append(scopes, s)
+	}
+
+	return scopes
+}